@@ -0,0 +1,226 @@
+data "sbercloud_availability_zones" "default" {}
+
+resource "sbercloud_vpc" "default" {
+  name = var.vpc_name
+  cidr = "192.168.0.0/16"
+}
+
+resource "sbercloud_vpc_subnet" "default" {
+  name       = var.subnet_name
+  cidr       = "192.168.0.0/20"
+  gateway_ip = "192.168.0.1"
+  vpc_id     = sbercloud_vpc.default.id
+}
+
+resource "sbercloud_networking_secgroup" "default" {
+  name = var.security_group_name
+}
+
+# HTTP access from anywhere
+resource "sbercloud_networking_secgroup_rule" "http_rule" {
+  security_group_id = sbercloud_networking_secgroup.default.id
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "tcp"
+  port_range_max    = 80
+  port_range_min    = 80
+  remote_ip_prefix  = "0.0.0.0/0"
+}
+
+data "sbercloud_images_image" "default" {
+  name        = var.ecs_image
+  most_recent = true
+}
+
+data "sbercloud_compute_flavors" "default" {
+  availability_zone = data.sbercloud_availability_zones.default.names[0]
+  performance_type  = "normal"
+  cpu_core_count    = 2
+  memory_size       = 4
+}
+
+resource "random_password" "password" {
+  length           = 16
+  special          = true
+  override_special = "!@"
+  min_numeric      = 1
+  min_lower        = 1
+  min_special      = 1
+}
+
+resource "sbercloud_compute_instance" "default" {
+  name               = var.ecs_name
+  image_id           = data.sbercloud_images_image.default.id
+  flavor_id          = data.sbercloud_compute_flavors.default.ids[0]
+  security_group_ids = [sbercloud_networking_secgroup.default.id]
+  availability_zone  = data.sbercloud_availability_zones.default.names[0]
+  system_disk_type   = "SSD"
+  admin_pass         = random_password.password.result
+
+  network {
+    uuid = sbercloud_vpc_subnet.default.id
+  }
+}
+
+resource "sbercloud_vpc_eip" "default" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    share_type  = "PER"
+    name        = var.bandwidth_name
+    size        = 10
+    charge_mode = "traffic"
+  }
+}
+
+resource "sbercloud_compute_eip_associate" "default" {
+  public_ip   = sbercloud_vpc_eip.default.address
+  instance_id = sbercloud_compute_instance.default.id
+}
+
+resource "null_resource" "provision" {
+  depends_on = [
+    sbercloud_compute_eip_associate.default
+  ]
+  provisioner "remote-exec" {
+    connection {
+      user     = "root"
+      password = random_password.password.result
+      host     = sbercloud_vpc_eip.default.address
+      port     = 22
+    }
+    inline = [
+      "yum -y install nginx",
+      "systemctl enable nginx",
+      "systemctl start nginx",
+      "systemctl status nginx",
+    ]
+  }
+}
+
+resource "sbercloud_fgs_function" "default" {
+  name        = var.function_name
+  app         = "default"
+  handler     = "index.handler"
+  memory_size = 128
+  timeout     = 3
+  runtime     = "Python3.6"
+  code_type   = "inline"
+
+  func_code = <<EOF
+# -*- coding:utf-8 -*-
+import json
+def handler(event, context):
+    if event["headers"].get("x-user-auth")=='cXpsdzQyVW9Xa1NVTX==':
+        return {
+            'statusCode': 200,
+            'body': json.dumps({
+                "status":"allow",
+                "context":{
+                    "user_name":"user1"
+                }
+            })
+        }
+    else:
+        return {
+            'statusCode': 200,
+            'body': json.dumps({
+                "status":"deny",
+                "context":{
+                    "code":"1001",
+                    "message":"incorrect username or password"
+                }
+            })
+        }
+EOF
+}
+
+resource "sbercloud_apig_instance" "default" {
+  name              = var.apig_instance_name
+  edition           = "BASIC"
+  vpc_id            = sbercloud_vpc.default.id
+  subnet_id         = sbercloud_vpc_subnet.default.id
+  security_group_id = sbercloud_networking_secgroup.default.id
+
+  availability_zones = [
+    data.sbercloud_availability_zones.default.names[0],
+  ]
+}
+
+resource "sbercloud_apig_custom_authorizer" "default" {
+  instance_id  = sbercloud_apig_instance.default.id
+  name         = var.apig_auth_name
+  function_urn = sbercloud_fgs_function.default.urn
+  type         = "FRONTEND"
+}
+
+resource "sbercloud_apig_response" "default" {
+  name        = var.apig_response_name
+  instance_id = sbercloud_apig_instance.default.id
+  group_id    = sbercloud_apig_group.default.id
+
+  rule {
+    error_type  = "AUTHORIZER_FAILURE"
+    body        = "{\"code\":\"$context.authorizer.frontend.code\",\"message\":\"$context.authorizer.frontend.message\"}"
+    status_code = 401
+  }
+}
+
+resource "sbercloud_apig_group" "default" {
+  name        = var.apig_group_name
+  instance_id = sbercloud_apig_instance.default.id
+}
+
+resource "sbercloud_apig_channel" "default" {
+  instance_id      = sbercloud_apig_instance.default.id
+  name             = var.apig_channel_name
+  port             = 80
+  balance_strategy = 1
+  member_type      = "ecs"
+
+  health_check {
+    protocol           = "HTTP"
+    threshold_normal   = 2
+    threshold_abnormal = 5
+    interval           = 10
+    timeout            = 5
+    path               = "/backend/users"
+    method             = "GET"
+    http_codes         = "200,401"
+  }
+
+  member {
+    id = sbercloud_compute_instance.default.id
+  }
+}
+
+resource "sbercloud_apig_api" "default" {
+  instance_id             = sbercloud_apig_instance.default.id
+  group_id                = sbercloud_apig_group.default.id
+  type                    = "Public"
+  name                    = var.apig_api_name
+  request_protocol        = "BOTH"
+  request_method          = "GET"
+  request_path            = "/terraform/users"
+  security_authentication = "AUTHORIZER"
+  matching                = "Exact"
+  response_id             = sbercloud_apig_response.default.id
+  authorizer_id           = sbercloud_apig_custom_authorizer.default.id
+
+  backend_params {
+    type     = "SYSTEM"
+    name     = "X-User-Auth"
+    location = "HEADER"
+    value    = "user_name"
+  }
+
+  web {
+    # ensure the backend server include this path or API debug will fail
+    path             = "/backend/users"
+    vpc_channel_id   = sbercloud_apig_channel.default.id
+    request_method   = "GET"
+    request_protocol = "HTTP"
+    timeout          = 5000
+  }
+}
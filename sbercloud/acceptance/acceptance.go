@@ -9,7 +9,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -25,6 +24,9 @@ var (
 	SBC_ENTERPRISE_PROJECT_ID_TEST = os.Getenv("SBC_ENTERPRISE_PROJECT_ID_TEST")
 	SBC_PROJECT_ID                 = os.Getenv("SBC_PROJECT_ID")
 
+	// The enterprise project which the resources are migrated to
+	SBC_ENTERPRISE_MIGRATE_PROJECT_ID_TEST = os.Getenv("SBC_ENTERPRISE_MIGRATE_PROJECT_ID_TEST")
+
 	SBC_DEPRECATED_ENVIRONMENT = os.Getenv("SBC_DEPRECATED_ENVIRONMENT")
 
 	SBC_ADMIN       = os.Getenv("SBC_ADMIN")
@@ -40,6 +42,23 @@ var (
 
 	SBC_FGS_TRIGGER_LTS_AGENCY = os.Getenv("SBC_FGS_TRIGGER_LTS_AGENCY")
 	SBC_OBS_BUCKET_NAME        = os.Getenv("SBC_OBS_BUCKET_NAME")
+	SBC_OBS_DESTINATION_BUCKET = os.Getenv("SBC_OBS_DESTINATION_BUCKET")
+
+	SBC_ER_TEST_ON = os.Getenv("SBC_ER_TEST_ON") // Whether to run the ER related tests.
+
+	SBC_WAF_ENABLE_FLAG = os.Getenv("SBC_WAF_ENABLE_FLAG") // Whether a WAF instance has been purchased.
+
+	SBC_CCE_PARTITION_AZ = os.Getenv("SBC_CCE_PARTITION_AZ") // The edge AZ in which the CCE partition is created.
+
+	SBC_CFW_INSTANCE_ID = os.Getenv("SBC_CFW_INSTANCE_ID")
+
+	SBC_DC_DIRECT_CONNECT_ID = os.Getenv("SBC_DC_DIRECT_CONNECT_ID") // The ID of an existing physical connection.
+
+	SBC_DEST_REGION     = os.Getenv("SBC_DEST_REGION")
+	SBC_DEST_PROJECT_ID = os.Getenv("SBC_DEST_PROJECT_ID")
+
+	SBC_CHARGING_MODE   = os.Getenv("SBC_CHARGING_MODE")
+	SBC_HIGH_COST_ALLOW = os.Getenv("SBC_HIGH_COST_ALLOW")
 )
 
 // TestAccProviderFactories is a static map containing only the main provider instance
@@ -56,6 +75,8 @@ func init() {
 			return TestAccProvider, nil
 		},
 	}
+
+	initTestMode()
 }
 
 // ServiceFunc the SberCloud resource query functions.
@@ -254,6 +275,7 @@ func preCheckRequiredEnvVars(t *testing.T) {
 	if SBC_REGION_NAME == "" {
 		t.Fatal("SBC_REGION_NAME must be set for acceptance tests")
 	}
+	preCheckTestMode(t)
 }
 
 func TestAccPreCheck(t *testing.T) {
@@ -272,24 +294,36 @@ func TestAccPreCheckEpsID(t *testing.T) {
 	if SBC_ENTERPRISE_PROJECT_ID == "" {
 		t.Skip("This environment does not support Enterprise Project ID tests")
 	}
+	preCheckTestMode(t)
+}
+
+func TestAccPreCheckMigrateEpsID(t *testing.T) {
+	if SBC_ENTERPRISE_PROJECT_ID_TEST == "" || SBC_ENTERPRISE_MIGRATE_PROJECT_ID_TEST == "" {
+		t.Skip("SBC_ENTERPRISE_PROJECT_ID_TEST and SBC_ENTERPRISE_MIGRATE_PROJECT_ID_TEST must be set for " +
+			"the enterprise project migration tests")
+	}
+	preCheckTestMode(t)
 }
 
 func TestAccPreCheckProject(t *testing.T) {
 	if SBC_ENTERPRISE_PROJECT_ID_TEST == "" {
 		t.Skip("This environment does not support project tests")
 	}
+	preCheckTestMode(t)
 }
 
 func TestAccPreCheckAdminOnly(t *testing.T) {
 	if SBC_ADMIN == "" {
 		t.Skip("Skipping test because it requires the admin privileges")
 	}
+	preCheckTestMode(t)
 }
 
 func TestAccPreCheckOBS(t *testing.T) {
 	if SBC_ACCESS_KEY == "" || SBC_SECRET_KEY == "" {
 		t.Skip("SBC_ACCESS_KEY and SBC_SECRET_KEY must be set for OBS acceptance tests")
 	}
+	preCheckTestMode(t)
 }
 
 // lintignore:AT003
@@ -298,6 +332,7 @@ func TestAccPreCheckSWRDomian(t *testing.T) {
 		t.Skip("SBC_SWR_SHARING_ACCOUNT must be set for swr domian tests, " +
 			"the value of SBC_SWR_SHARING_ACCOUNT should be another IAM user name")
 	}
+	preCheckTestMode(t)
 }
 
 // lintignore:AT003
@@ -305,28 +340,117 @@ func TestAccPreCheckFgsTrigger(t *testing.T) {
 	if SBC_FGS_TRIGGER_LTS_AGENCY == "" {
 		t.Skip("SBC_FGS_TRIGGER_LTS_AGENCY must be set for FGS trigger acceptance tests")
 	}
+	preCheckTestMode(t)
+}
+
+// lintignore:AT003
+func TestAccPreCheckLtsHostGroup(t *testing.T) {
+	if SBC_ACCESS_KEY == "" || SBC_SECRET_KEY == "" || SBC_PROJECT_ID == "" {
+		t.Skip("SBC_ACCESS_KEY, SBC_SECRET_KEY and SBC_PROJECT_ID must be set for installing ICAgent " +
+			"in the LTS host group acceptance tests")
+	}
+	preCheckTestMode(t)
 }
 
 func TestAccPreCheckOBSBucket(t *testing.T) {
 	if SBC_OBS_BUCKET_NAME == "" {
 		t.Skip("SBC_OBS_BUCKET_NAME must be set for OBS object acceptance tests")
 	}
+	preCheckTestMode(t)
+}
+
+// lintignore:AT003
+func TestAccPreCheckOBSDestinationBucket(t *testing.T) {
+	if SBC_OBS_DESTINATION_BUCKET == "" {
+		t.Skip("SBC_OBS_DESTINATION_BUCKET must be set for OBS destination tests, " +
+			"the destination bucket must be in another region")
+	}
+	preCheckTestMode(t)
+}
+
+// lintignore:AT003
+func TestAccPreCheckER(t *testing.T) {
+	if SBC_ER_TEST_ON == "" {
+		t.Skip("SBC_ER_TEST_ON must be set for ER acceptance tests")
+	}
+	preCheckTestMode(t)
+}
+
+// lintignore:AT003
+func TestAccPrecheckWafInstance(t *testing.T) {
+	if SBC_WAF_ENABLE_FLAG == "" {
+		t.Skip("SBC_WAF_ENABLE_FLAG must be set for WAF acceptance tests")
+	}
+	preCheckTestMode(t)
+}
+
+// lintignore:AT003
+func TestAccPreCheckCcePartitionAz(t *testing.T) {
+	if SBC_CCE_PARTITION_AZ == "" {
+		t.Skip("SBC_CCE_PARTITION_AZ must be set for CCE partition acceptance tests")
+	}
+	preCheckTestMode(t)
+}
+
+// lintignore:AT003
+func TestAccPreCheckCfw(t *testing.T) {
+	if SBC_CFW_INSTANCE_ID == "" {
+		t.Skip("SBC_CFW_INSTANCE_ID must be set for CFW acceptance tests")
+	}
+	preCheckTestMode(t)
+}
+
+// lintignore:AT003
+func TestAccPreCheckDcDirectConnection(t *testing.T) {
+	if SBC_DC_DIRECT_CONNECT_ID == "" {
+		t.Skip("SBC_DC_DIRECT_CONNECT_ID must be set for DC virtual interface acceptance tests")
+	}
+	preCheckTestMode(t)
+}
+
+// lintignore:AT003
+func TestAccPreCheckDestProject(t *testing.T) {
+	if SBC_DEST_REGION == "" || SBC_DEST_PROJECT_ID == "" {
+		t.Skip("SBC_DEST_REGION and SBC_DEST_PROJECT_ID must be set for cross-region acceptance tests")
+	}
+	preCheckTestMode(t)
+}
+
+// lintignore:AT003
+func TestAccPreCheckChargingMode(t *testing.T) {
+	if SBC_CHARGING_MODE != "prePaid" {
+		t.Skip("This environment does not support prepaid tests")
+	}
+	preCheckTestMode(t)
+}
+
+// lintignore:AT003
+func TestAccPreCheckHighCostAllow(t *testing.T) {
+	if SBC_HIGH_COST_ALLOW == "" {
+		t.Skip("Do not allow expensive testing")
+	}
+	preCheckTestMode(t)
 }
 
 func RandomAccResourceName() string {
-	return fmt.Sprintf("tf_acc_test_%s", acctest.RandString(5))
+	return fmt.Sprintf("tf_acc_test_%s", randString(5))
 }
 
 func RandomAccResourceNameWithDash() string {
-	return fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	return fmt.Sprintf("tf-acc-test-%s", randString(5))
+}
+
+func RandomPassword() string {
+	return fmt.Sprintf("%s%s%s%d", randStringFromCharSet(2, "ABCDEFGHIJKLMNOPQRSTUVWXZY"),
+		randString(3), randStringFromCharSet(2, "~!@#%^*-_=+?"), randIntRange(1000, 9999))
 }
 
 func RandomCidr() string {
-	return fmt.Sprintf("172.16.%d.0/24", acctest.RandIntRange(0, 255))
+	return fmt.Sprintf("172.16.%d.0/24", randIntRange(0, 255))
 }
 
 func RandomCidrAndGatewayIp() (string, string) {
-	seed := acctest.RandIntRange(0, 255)
+	seed := randIntRange(0, 255)
 	return fmt.Sprintf("172.16.%d.0/24", seed), fmt.Sprintf("172.16.%d.1", seed)
 }
 
@@ -0,0 +1,135 @@
+package apig
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getApplicationAuthorizationFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.ApigV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v2/{project_id}/apigw/instances/{instance_id}/app-auths/binded-apis"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{instance_id}", state.Primary.Attributes["instance_id"])
+	getPath += fmt.Sprintf("?app_id=%s&env_id=%s", state.Primary.Attributes["application_id"],
+		state.Primary.Attributes["env_id"])
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	auths := utils.PathSearch("auths", respBody, make([]interface{}, 0)).([]interface{})
+	if len(auths) < 1 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return auths, nil
+}
+
+func TestAccApplicationAuthorization_basic(t *testing.T) {
+	var (
+		auths []interface{}
+
+		rName = "sbercloud_apig_application_authorization.test"
+		name  = acceptance.RandomAccResourceName()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&auths,
+		getApplicationAuthorizationFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationAuthorization_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "application_id", "sbercloud_apig_application.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "env_id", "sbercloud_apig_environment.test", "id"),
+					resource.TestCheckResourceAttr(rName, "api_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccApplicationAuthorizationImportStateFunc(rName),
+			},
+		},
+	})
+}
+
+func testAccApplicationAuthorizationImportStateFunc(rName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", rName)
+		}
+		var (
+			instanceId = rs.Primary.Attributes["instance_id"]
+			envId      = rs.Primary.Attributes["env_id"]
+			appId      = rs.Primary.Attributes["application_id"]
+		)
+		if instanceId == "" || envId == "" || appId == "" {
+			return "", fmt.Errorf("missing some attributes, want '{instance_id}/{env_id}/{application_id}', "+
+				"but '%s/%s/%s'", instanceId, envId, appId)
+		}
+		return fmt.Sprintf("%s/%s/%s", instanceId, envId, appId), nil
+	}
+}
+
+func testAccApplicationAuthorization_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "sbercloud_apig_environment" "test" {
+  instance_id = sbercloud_apig_instance.test.id
+  name        = "%[2]s"
+}
+
+resource "sbercloud_apig_api_publishment" "test" {
+  instance_id = sbercloud_apig_instance.test.id
+  env_id      = sbercloud_apig_environment.test.id
+  api_id      = sbercloud_apig_api.test.id
+}
+
+resource "sbercloud_apig_application" "test" {
+  instance_id = sbercloud_apig_instance.test.id
+  name        = "%[2]s"
+}
+
+resource "sbercloud_apig_application_authorization" "test" {
+  instance_id    = sbercloud_apig_instance.test.id
+  application_id = sbercloud_apig_application.test.id
+  env_id         = sbercloud_apig_environment.test.id
+  api_ids        = [sbercloud_apig_api_publishment.test.api_id]
+}
+`, testAccApi_basic(testAccApi_base(name), name), name)
+}
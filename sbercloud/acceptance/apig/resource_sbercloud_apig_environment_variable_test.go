@@ -0,0 +1,109 @@
+package apig
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/apigw/dedicated/v2/environments"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getEnvironmentVariableFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.ApigV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	return environments.GetVariable(client, state.Primary.Attributes["instance_id"], state.Primary.ID).Extract()
+}
+
+func TestAccEnvironmentVariable_basic(t *testing.T) {
+	var (
+		variable environments.Variable
+
+		rName = "sbercloud_apig_environment_variable.test"
+		name  = acceptance.RandomAccResourceName()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&variable,
+		getEnvironmentVariableFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentVariable_basic(name, "/backend/v1"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "group_id", "sbercloud_apig_group.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "env_id", "sbercloud_apig_environment.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", "backend_path"),
+					resource.TestCheckResourceAttr(rName, "value", "/backend/v1"),
+				),
+			},
+			{
+				Config: testAccEnvironmentVariable_basic(name, "/backend/v2"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "value", "/backend/v2"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccEnvironmentVariableImportStateFunc(rName),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentVariableImportStateFunc(rName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", rName)
+		}
+		if rs.Primary.Attributes["instance_id"] == "" || rs.Primary.ID == "" {
+			return "", fmt.Errorf("missing some attributes, want '{instance_id}/{id}', but '%s/%s'",
+				rs.Primary.Attributes["instance_id"], rs.Primary.ID)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccEnvironmentVariable_basic(name, value string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "sbercloud_apig_group" "test" {
+  name        = "%[2]s"
+  instance_id = sbercloud_apig_instance.test.id
+}
+
+resource "sbercloud_apig_environment" "test" {
+  name        = "%[2]s"
+  instance_id = sbercloud_apig_instance.test.id
+}
+
+resource "sbercloud_apig_environment_variable" "test" {
+  instance_id = sbercloud_apig_instance.test.id
+  group_id    = sbercloud_apig_group.test.id
+  env_id      = sbercloud_apig_environment.test.id
+  name        = "backend_path"
+  value       = "%[3]s"
+}
+`, testAccEnvironment_base(name), name, value)
+}
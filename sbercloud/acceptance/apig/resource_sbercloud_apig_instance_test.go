@@ -0,0 +1,374 @@
+package apig
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/apigw/dedicated/v2/instances"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getInstanceFunc(config *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := config.ApigV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	return instances.Get(client, state.Primary.ID).Extract()
+}
+
+func TestAccInstance_basic(t *testing.T) {
+	var (
+		instance instances.Instance
+
+		resourceName = "sbercloud_apig_instance.test"
+		rName        = acceptance.RandomAccResourceName()
+		updateName   = acceptance.RandomAccResourceName()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&instance,
+		getInstanceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckEpsID(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstance_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "edition", "BASIC"),
+					resource.TestCheckResourceAttr(resourceName, "enterprise_project_id", acceptance.SBC_ENTERPRISE_PROJECT_ID_TEST),
+					resource.TestCheckResourceAttr(resourceName, "maintain_begin", "14:00:00"),
+					resource.TestCheckResourceAttr(resourceName, "maintain_end", "18:00:00"),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+					resource.TestCheckResourceAttrSet(resourceName, "vpc_ingress_address"),
+				),
+			},
+			{
+				Config: testAccInstance_update(updateName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", updateName),
+					resource.TestCheckResourceAttr(resourceName, "edition", "BASIC"),
+					resource.TestCheckResourceAttr(resourceName, "enterprise_project_id", acceptance.SBC_ENTERPRISE_PROJECT_ID_TEST),
+					resource.TestCheckResourceAttr(resourceName, "maintain_begin", "18:00:00"),
+					resource.TestCheckResourceAttr(resourceName, "maintain_end", "22:00:00"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "vpc_ingress_address"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccInstance_egress(t *testing.T) {
+	var (
+		instance instances.Instance
+
+		resourceName = "sbercloud_apig_instance.test"
+		rName        = acceptance.RandomAccResourceName()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&instance,
+		getInstanceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckEpsID(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstance_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "edition", "BASIC"),
+					resource.TestCheckResourceAttr(resourceName, "enterprise_project_id", acceptance.SBC_ENTERPRISE_PROJECT_ID_TEST),
+					resource.TestCheckResourceAttr(resourceName, "maintain_begin", "14:00:00"),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+					resource.TestCheckResourceAttrSet(resourceName, "vpc_ingress_address"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_size", "0"),
+				),
+			},
+			{
+				Config: testAccInstance_egress(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "vpc_ingress_address"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_size", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "egress_address"),
+				),
+			},
+			{
+				Config: testAccInstance_egressUpdate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "vpc_ingress_address"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_size", "5"),
+					resource.TestCheckResourceAttrSet(resourceName, "egress_address"),
+				),
+			},
+			{
+				Config: testAccInstance_basic(rName), // Unbind egress nat
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "vpc_ingress_address"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_size", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccInstance_ingress(t *testing.T) {
+	var (
+		instance instances.Instance
+
+		resourceName = "sbercloud_apig_instance.test"
+		rName        = acceptance.RandomAccResourceName()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&instance,
+		getInstanceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckEpsID(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstance_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "edition", "BASIC"),
+					resource.TestCheckResourceAttr(resourceName, "enterprise_project_id", acceptance.SBC_ENTERPRISE_PROJECT_ID_TEST),
+					resource.TestCheckResourceAttr(resourceName, "maintain_begin", "14:00:00"),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+					resource.TestCheckResourceAttrSet(resourceName, "vpc_ingress_address"),
+				),
+			},
+			{
+				Config: testAccInstance_ingress(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "vpc_ingress_address"),
+					resource.TestCheckResourceAttrSet(resourceName, "eip_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "ingress_address"),
+				),
+			},
+			{
+				Config: testAccInstance_ingressUpdate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "vpc_ingress_address"),
+					resource.TestCheckResourceAttrSet(resourceName, "eip_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "ingress_address"),
+				),
+			},
+			{
+				Config: testAccInstance_basic(rName), // Unbind ingress eip
+				Check: resource.ComposeTestCheckFunc(rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "vpc_ingress_address"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccInstance_basic(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_apig_instance" "test" {
+  vpc_id             = sbercloud_vpc.test.id
+  subnet_id          = sbercloud_vpc_subnet.test.id
+  security_group_id  = sbercloud_networking_secgroup.test.id
+  availability_zones = slice(data.sbercloud_availability_zones.test.names, 0, 1)
+
+  edition               = "BASIC"
+  name                  = "%[2]s"
+  enterprise_project_id = "%[3]s"
+  maintain_begin        = "14:00:00"
+  description           = "created by acc test"
+}
+`, acceptance.TestBaseNetwork(rName), rName, acceptance.SBC_ENTERPRISE_PROJECT_ID_TEST)
+}
+
+func testAccInstance_update(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_networking_secgroup" "new" {
+  name = "%[2]s_new"
+}
+
+resource "sbercloud_apig_instance" "test" {
+  vpc_id             = sbercloud_vpc.test.id
+  subnet_id          = sbercloud_vpc_subnet.test.id
+  security_group_id  = sbercloud_networking_secgroup.new.id
+  availability_zones = slice(data.sbercloud_availability_zones.test.names, 0, 1)
+
+  edition               = "BASIC"
+  name                  = "%[2]s"
+  enterprise_project_id = "%[3]s"
+  maintain_begin        = "18:00:00"
+}
+`, acceptance.TestBaseNetwork(rName), rName, acceptance.SBC_ENTERPRISE_PROJECT_ID_TEST)
+}
+
+func testAccInstance_egress(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_apig_instance" "test" {
+  vpc_id             = sbercloud_vpc.test.id
+  subnet_id          = sbercloud_vpc_subnet.test.id
+  security_group_id  = sbercloud_networking_secgroup.test.id
+  availability_zones = slice(data.sbercloud_availability_zones.test.names, 0, 1)
+
+  edition               = "BASIC"
+  name                  = "%[2]s"
+  enterprise_project_id = "%[3]s"
+  bandwidth_size        = 3
+}
+`, acceptance.TestBaseNetwork(rName), rName, acceptance.SBC_ENTERPRISE_PROJECT_ID_TEST)
+}
+
+func testAccInstance_egressUpdate(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_apig_instance" "test" {
+  vpc_id             = sbercloud_vpc.test.id
+  subnet_id          = sbercloud_vpc_subnet.test.id
+  security_group_id  = sbercloud_networking_secgroup.test.id
+  availability_zones = slice(data.sbercloud_availability_zones.test.names, 0, 1)
+
+  edition               = "BASIC"
+  name                  = "%[2]s"
+  enterprise_project_id = "%[3]s"
+  bandwidth_size        = 5
+}
+`, acceptance.TestBaseNetwork(rName), rName, acceptance.SBC_ENTERPRISE_PROJECT_ID_TEST)
+}
+
+func testAccInstance_ingress(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_vpc_eip" "test" {
+  publicip {
+    type = "5_bgp"
+  }
+
+  bandwidth {
+    name        = "%[2]s"
+    size        = 3
+    share_type  = "PER"
+    charge_mode = "traffic"
+  }
+}
+
+resource "sbercloud_apig_instance" "test" {
+  vpc_id             = sbercloud_vpc.test.id
+  subnet_id          = sbercloud_vpc_subnet.test.id
+  security_group_id  = sbercloud_networking_secgroup.test.id
+  availability_zones = slice(data.sbercloud_availability_zones.test.names, 0, 1)
+
+  edition               = "BASIC"
+  name                  = "%[2]s"
+  enterprise_project_id = "%[3]s"
+  eip_id                = sbercloud_vpc_eip.test.id
+}
+`, acceptance.TestBaseNetwork(rName), rName, acceptance.SBC_ENTERPRISE_PROJECT_ID_TEST)
+}
+
+func testAccInstance_ingressUpdate(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_vpc_eip" "update" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    name        = "%[2]s"
+    size        = 4
+    share_type  = "PER"
+    charge_mode = "traffic"
+  }
+}
+
+resource "sbercloud_apig_instance" "test" {
+  vpc_id             = sbercloud_vpc.test.id
+  subnet_id          = sbercloud_vpc_subnet.test.id
+  security_group_id  = sbercloud_networking_secgroup.test.id
+  availability_zones = slice(data.sbercloud_availability_zones.test.names, 0, 1)
+
+  edition               = "BASIC"
+  name                  = "%[2]s"
+  enterprise_project_id = "%[3]s"
+  maintain_begin        = "14:00:00"
+  eip_id                = sbercloud_vpc_eip.update.id
+}
+`, acceptance.TestBaseNetwork(rName), rName, acceptance.SBC_ENTERPRISE_PROJECT_ID_TEST)
+}
@@ -1,29 +1,46 @@
-package sbercloud
+package ces
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
 	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/ces/alarmrule"
-	"testing"
 )
 
+func getAlarmRuleResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.CesV1Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CES v1 client: %s", err)
+	}
+	return alarmrule.Get(client, state.Primary.ID).Extract()
+}
+
 func TestAccCESAlarmRule_basic(t *testing.T) {
 	var ar alarmrule.AlarmRule
-	rName := fmt.Sprintf("tf-acc-%s", acctest.RandString(5))
+	rName := acceptance.RandomAccResourceNameWithDash()
 	resourceName := "sbercloud_ces_alarmrule.alarmrule_1"
 
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&ar,
+		getAlarmRuleResourceFunc,
+	)
+
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testCESAlarmRuleDestroy,
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testCESAlarmRule_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testCESAlarmRuleExists(resourceName, &ar),
+					rc.CheckResourceExists(),
 					resource.TestCheckResourceAttr(resourceName, "alarm_name", fmt.Sprintf("rule-%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, "alarm_enabled", "true"),
 					resource.TestCheckResourceAttr(resourceName, "alarm_action_enabled", "true"),
@@ -40,57 +57,6 @@ func TestAccCESAlarmRule_basic(t *testing.T) {
 	})
 }
 
-func testCESAlarmRuleDestroy(s *terraform.State) error {
-	config := testAccProvider.Meta().(*config.Config)
-	networkingClient, err := config.CesV1Client(SBC_REGION_NAME)
-	if err != nil {
-		return fmt.Errorf("Error creating SberCloud ces client: %s", err)
-	}
-
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "sbercloud_ces_alarmrule" {
-			continue
-		}
-
-		id := rs.Primary.ID
-		_, err := alarmrule.Get(networkingClient, id).Extract()
-		if err == nil {
-			return fmt.Errorf("Alarm rule still exists")
-		}
-	}
-
-	return nil
-}
-
-func testCESAlarmRuleExists(n string, ar *alarmrule.AlarmRule) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[n]
-		if !ok {
-			return fmt.Errorf("Not found: %s", n)
-		}
-
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("No ID is set")
-		}
-
-		config := testAccProvider.Meta().(*config.Config)
-		networkingClient, err := config.CesV1Client(SBC_REGION_NAME)
-		if err != nil {
-			return fmt.Errorf("Error creating SberCloud ces client: %s", err)
-		}
-
-		id := rs.Primary.ID
-		found, err := alarmrule.Get(networkingClient, id).Extract()
-		if err != nil {
-			return err
-		}
-
-		*ar = *found
-
-		return nil
-	}
-}
-
 func testCESAlarmRule_base(rName string) string {
 	return fmt.Sprintf(`
 data "sbercloud_availability_zones" "test" {}
@@ -1,30 +1,61 @@
-package sbercloud
+package css
 
 import (
 	"fmt"
 	"testing"
 
 	"github.com/chnsz/golangsdk"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
 )
 
+func getCssClusterResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.CssV1Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	url, err := acceptance.ReplaceVarsForTest(state, "clusters/{id}")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL(url), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
 func TestAccCssClusterV1_basic(t *testing.T) {
-	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	var obj interface{}
+	name := acceptance.RandomAccResourceNameWithDash()
 	resourceName := "sbercloud_css_cluster.cluster"
 
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getCssClusterResourceFunc,
+	)
+
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckCssClusterV1Destroy,
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCssClusterV1_basic(name),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCssClusterV1Exists(),
-					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s", name)),
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttr(resourceName, "expect_node_num", "1"),
 					resource.TestCheckResourceAttr(resourceName, "engine_type", "elasticsearch"),
 					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
@@ -34,7 +65,7 @@ func TestAccCssClusterV1_basic(t *testing.T) {
 			{
 				Config: testAccCssClusterV1_update(name),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCssClusterV1Exists(),
+					rc.CheckResourceExists(),
 					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar_update"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key_update", "value"),
 				),
@@ -44,19 +75,26 @@ func TestAccCssClusterV1_basic(t *testing.T) {
 }
 
 func TestAccCssClusterV1_security(t *testing.T) {
-	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	var obj interface{}
+	name := acceptance.RandomAccResourceNameWithDash()
 	resourceName := "sbercloud_css_cluster.cluster"
 
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getCssClusterResourceFunc,
+	)
+
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckCssClusterV1Destroy,
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCssClusterV1_security(name),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCssClusterV1Exists(),
-					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s", name)),
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttr(resourceName, "expect_node_num", "1"),
 					resource.TestCheckResourceAttr(resourceName, "engine_type", "elasticsearch"),
 					resource.TestCheckResourceAttr(resourceName, "security_mode", "true"),
@@ -66,65 +104,6 @@ func TestAccCssClusterV1_security(t *testing.T) {
 	})
 }
 
-func testAccCheckCssClusterV1Destroy(s *terraform.State) error {
-	config := testAccProvider.Meta().(*config.Config)
-	client, err := config.CssV1Client(SBC_REGION_NAME)
-	if err != nil {
-		return fmt.Errorf("Error creating sdk client, err=%s", err)
-	}
-
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "sbercloud_css_cluster" {
-			continue
-		}
-
-		url, err := replaceVarsForTest(rs, "clusters/{id}")
-		if err != nil {
-			return err
-		}
-		url = client.ServiceURL(url)
-
-		_, err = client.Get(url, nil, &golangsdk.RequestOpts{
-			MoreHeaders: map[string]string{"Content-Type": "application/json"}})
-		if err == nil {
-			return fmt.Errorf("sbercloud_css_cluster still exists at %s", url)
-		}
-	}
-
-	return nil
-}
-
-func testAccCheckCssClusterV1Exists() resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		config := testAccProvider.Meta().(*config.Config)
-		client, err := config.CssV1Client(SBC_REGION_NAME)
-		if err != nil {
-			return fmt.Errorf("Error creating sdk client, err=%s", err)
-		}
-
-		rs, ok := s.RootModule().Resources["sbercloud_css_cluster.cluster"]
-		if !ok {
-			return fmt.Errorf("Error checking sbercloud_css_cluster.cluster exist, err=not found this resource")
-		}
-
-		url, err := replaceVarsForTest(rs, "clusters/{id}")
-		if err != nil {
-			return fmt.Errorf("Error checking sbercloud_css_cluster.cluster exist, err=building url failed: %s", err)
-		}
-		url = client.ServiceURL(url)
-
-		_, err = client.Get(url, nil, &golangsdk.RequestOpts{
-			MoreHeaders: map[string]string{"Content-Type": "application/json"}})
-		if err != nil {
-			if _, ok := err.(golangsdk.ErrDefault404); ok {
-				return fmt.Errorf("sbercloud_css_cluster.cluster is not exist")
-			}
-			return fmt.Errorf("Error checking sbercloud_css_cluster.cluster exist, err=send request failed: %s", err)
-		}
-		return nil
-	}
-}
-
 func testAccCssClusterV1_base(name string) string {
 	return fmt.Sprintf(`
 data "sbercloud_availability_zones" "test" {}
@@ -0,0 +1,52 @@
+package dc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccDataSourceDcConnections_basic(t *testing.T) {
+	var (
+		all    = "data.sbercloud_dc_connections.test"
+		byId   = "data.sbercloud_dc_connections.filter_by_id"
+		dcAll  = acceptance.InitDataSourceCheck(all)
+		dcById = acceptance.InitDataSourceCheck(byId)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDcDirectConnection(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceDcConnections_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					dcAll.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(all, "direct_connects.0.id"),
+					dcById.CheckResourceExists(),
+					resource.TestCheckResourceAttr(byId, "direct_connects.#", "1"),
+					resource.TestCheckResourceAttr(byId, "direct_connects.0.id", acceptance.SBC_DC_DIRECT_CONNECT_ID),
+					resource.TestCheckResourceAttrSet(byId, "direct_connects.0.name"),
+					resource.TestCheckResourceAttrSet(byId, "direct_connects.0.bandwidth"),
+					resource.TestCheckResourceAttrSet(byId, "direct_connects.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceDcConnections_basic() string {
+	return fmt.Sprintf(`
+data "sbercloud_dc_connections" "test" {}
+
+data "sbercloud_dc_connections" "filter_by_id" {
+  connection_id = "%s"
+}
+`, acceptance.SBC_DC_DIRECT_CONNECT_ID)
+}
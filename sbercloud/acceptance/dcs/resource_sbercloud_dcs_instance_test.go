@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/chnsz/golangsdk/openstack/dcs/v1/instances"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
@@ -14,7 +13,7 @@ import (
 
 func TestAccDcsInstancesV1_basic(t *testing.T) {
 	var instance instances.Instance
-	var instanceName = fmt.Sprintf("dcs_instance_%s", acctest.RandString(5))
+	var instanceName = acceptance.RandomAccResourceName()
 	resourceName := "sbercloud_dcs_instance.instance_1"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -50,7 +49,7 @@ func TestAccDcsInstancesV1_basic(t *testing.T) {
 
 func TestAccDcsInstancesV1_single(t *testing.T) {
 	var instance instances.Instance
-	var instanceName = fmt.Sprintf("dcs_instance_%s", acctest.RandString(5))
+	var instanceName = acceptance.RandomAccResourceName()
 	resourceName := "sbercloud_dcs_instance.instance_1"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -3,14 +3,13 @@ package dcs
 import (
 	"fmt"
 	"github.com/chnsz/golangsdk/openstack/dcs/v1/instances"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
 	"testing"
 )
 
 func TestAccDCSParameters_basic(t *testing.T) {
-	var instanceName = fmt.Sprintf("testacc_dcs_instance_%s", acctest.RandString(5))
+	var instanceName = acceptance.RandomAccResourceName()
 	var instance instances.Instance
 	resourceInstanceName := "sbercloud_dcs_instance.instance_1"
 	resourceParamsName := "sbercloud_dcs_parameters.test_new"
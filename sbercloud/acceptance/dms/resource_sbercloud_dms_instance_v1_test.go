@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/chnsz/golangsdk/openstack/dms/v1/instances"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
@@ -14,7 +13,7 @@ import (
 
 func TestAccDmsInstancesV1_Rabbitmq(t *testing.T) {
 	var instance instances.Instance
-	var instanceName = fmt.Sprintf("dms_instance_%s", acctest.RandString(5))
+	var instanceName = acceptance.RandomAccResourceName()
 	resourceName := "sbercloud_dms_instance.instance_1"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -38,7 +37,7 @@ func TestAccDmsInstancesV1_Rabbitmq(t *testing.T) {
 
 func TestAccDmsInstancesV1_Kafka(t *testing.T) {
 	var instance instances.Instance
-	var instanceName = fmt.Sprintf("dms_instance_%s", acctest.RandString(5))
+	var instanceName = acceptance.RandomAccResourceName()
 	resourceName := "sbercloud_dms_instance.instance_1"
 
 	resource.ParallelTest(t, resource.TestCase{
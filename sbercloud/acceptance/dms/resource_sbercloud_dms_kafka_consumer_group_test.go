@@ -0,0 +1,104 @@
+package dms
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getDmsKafkaConsumerGroupFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.DmsV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := state.Primary.Attributes["instance_id"]
+	name := state.Primary.Attributes["name"]
+	getPath := client.Endpoint + fmt.Sprintf("v2/%s/instances/%s/groups?group=%s", client.ProjectID, instanceId,
+		url.QueryEscape(name))
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	group := utils.PathSearch(fmt.Sprintf("groups[?group_id=='%s']|[0]", name), respBody, nil)
+	if group == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return group, nil
+}
+
+func TestAccDmsKafkaConsumerGroup_basic(t *testing.T) {
+	var group interface{}
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_dms_kafka_consumer_group.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&group,
+		getDmsKafkaConsumerGroupFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaConsumerGroup_basic(rName, "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"sbercloud_dms_kafka_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by terraform"),
+					resource.TestCheckResourceAttr(resourceName, "state", "EMPTY"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccDmsKafkaConsumerGroup_basic(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsKafkaConsumerGroup_basic(rName, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_dms_kafka_consumer_group" "test" {
+  instance_id = sbercloud_dms_kafka_instance.test.id
+  name        = "%s"
+  description = "%s"
+}
+`, testAccDmsKafkaInstance_basic(rName), strings.ReplaceAll(rName, "_", "-"), description)
+}
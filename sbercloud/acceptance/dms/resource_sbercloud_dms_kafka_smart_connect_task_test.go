@@ -0,0 +1,204 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getDmsKafkaSmartConnectTaskFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.DmsV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS client: %s", err)
+	}
+
+	getPath := client.Endpoint + fmt.Sprintf("v2/%s/instances/%s/connector/tasks/%s", client.ProjectID,
+		state.Primary.Attributes["instance_id"], state.Primary.ID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccDmsKafkaSmartConnectTask_obsDump(t *testing.T) {
+	var task interface{}
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_dms_kafka_smart_connect_task.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&task,
+		getDmsKafkaSmartConnectTaskFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaSmartConnectTask_obsDump(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUNNING"),
+					resource.TestCheckResourceAttrPair(resourceName, "topics.0",
+						"sbercloud_dms_kafka_topic.topic", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_task.0.obs_bucket_name",
+						"sbercloud_obs_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "destination_task.0.deliver_time_interval", "300"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDmsKafkaSmartConnectTaskImportStateFunc(resourceName),
+				ImportStateVerifyIgnore: []string{
+					"destination_task.0.access_key",
+					"destination_task.0.secret_key",
+				},
+			},
+		},
+	})
+}
+
+func TestAccDmsKafkaSmartConnectTask_mirror(t *testing.T) {
+	var task interface{}
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_dms_kafka_smart_connect_task.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&task,
+		getDmsKafkaSmartConnectTaskFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaSmartConnectTask_mirror(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceName, "topics_regex", ".*"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_task.0.peer_instance_id",
+						"sbercloud_dms_kafka_instance.peer", "id"),
+					resource.TestCheckResourceAttr(resourceName, "source_task.0.direction", "pull"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccDmsKafkaSmartConnectTaskImportStateFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"source_task.0.password"},
+			},
+		},
+	})
+}
+
+func testAccDmsKafkaSmartConnectTaskImportStateFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccDmsKafkaSmartConnectTask_obsDump(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "sbercloud_obs_bucket" "test" {
+  bucket        = "%[2]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "sbercloud_dms_kafka_smart_connect" "test" {
+  instance_id = sbercloud_dms_kafka_instance.test.id
+}
+
+resource "sbercloud_dms_kafka_smart_connect_task" "test" {
+  instance_id = sbercloud_dms_kafka_instance.test.id
+  name        = "%[2]s"
+  topics      = [sbercloud_dms_kafka_topic.topic.name]
+
+  destination_task {
+    consumer_strategy     = "earliest"
+    deliver_time_interval = 300
+    access_key            = "%[3]s"
+    secret_key            = "%[4]s"
+    obs_bucket_name       = sbercloud_obs_bucket.test.bucket
+    obs_path              = "dump"
+    partition_format      = "yyyy/MM/dd/HH/mm"
+  }
+
+  depends_on = [sbercloud_dms_kafka_smart_connect.test]
+}
+`, testAccDmsKafkaTopic_basic(rName), rName, acceptance.SBC_ACCESS_KEY, acceptance.SBC_SECRET_KEY)
+}
+
+func testAccDmsKafkaSmartConnectTask_mirror(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "sbercloud_dms_kafka_instance" "peer" {
+  name              = "%[2]s-peer"
+  vpc_id            = data.sbercloud_vpc.test.id
+  network_id        = data.sbercloud_vpc_subnet.test.id
+  security_group_id = sbercloud_networking_secgroup.test.id
+  available_zones   = [data.sbercloud_dms_az.test.id]
+  product_id        = data.sbercloud_dms_product.test.id
+  engine_version    = data.sbercloud_dms_product.test.version
+  bandwidth         = data.sbercloud_dms_product.test.bandwidth
+  storage_space     = data.sbercloud_dms_product.test.storage
+  storage_spec_code = data.sbercloud_dms_product.test.storage_spec_code
+  manager_user      = "kafka-user"
+  manager_password  = "Kafkatest@123"
+}
+
+resource "sbercloud_dms_kafka_smart_connect" "test" {
+  instance_id = sbercloud_dms_kafka_instance.test.id
+}
+
+resource "sbercloud_dms_kafka_smart_connect_task" "test" {
+  instance_id  = sbercloud_dms_kafka_instance.test.id
+  name         = "%[2]s"
+  topics_regex = ".*"
+
+  source_task {
+    current_cluster_name = "A"
+    peer_cluster_name    = "B"
+    direction            = "pull"
+    peer_instance_id     = sbercloud_dms_kafka_instance.peer.id
+  }
+
+  depends_on = [sbercloud_dms_kafka_smart_connect.test]
+}
+`, testAccDmsKafkaInstance_basic(rName), rName)
+}
@@ -0,0 +1,89 @@
+package dms
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getDmsRabbitmqExchangeBindingFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.DmsV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS client: %s", err)
+	}
+
+	attributes := state.Primary.Attributes
+	listPath := client.Endpoint + fmt.Sprintf("v2/%s/rabbitmq/instances/%s/vhosts/%s/exchanges/%s/binding",
+		client.ProjectID, attributes["instance_id"], encodeRabbitmqPathParam(attributes["vhost"]),
+		encodeRabbitmqPathParam(attributes["exchange"]))
+	expression := fmt.Sprintf("items[?destination_type=='%s' && destination=='%s' && routing_key=='%s']|[0]",
+		strings.ToLower(attributes["destination_type"]), attributes["destination"], attributes["routing_key"])
+	return getRabbitmqItem(client, listPath, expression)
+}
+
+func TestAccDmsRabbitmqExchangeBinding_basic(t *testing.T) {
+	var binding interface{}
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_dms_rabbitmq_exchange_binding.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&binding,
+		getDmsRabbitmqExchangeBindingFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRabbitmqExchangeBinding_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "exchange",
+						"sbercloud_dms_rabbitmq_exchange.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "destination_type", "Queue"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination",
+						"sbercloud_dms_rabbitmq_queue.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "routing_key", "expired"),
+					resource.TestCheckResourceAttrSet(resourceName, "properties_key"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsRabbitmqExchangeBinding_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_dms_rabbitmq_queue" "dead_letter" {
+  instance_id = sbercloud_dms_rabbitmq_instance.test.id
+  vhost       = sbercloud_dms_rabbitmq_vhost.test.name
+  name        = "%s-dead-letter"
+}
+
+resource "sbercloud_dms_rabbitmq_exchange_binding" "test" {
+  instance_id      = sbercloud_dms_rabbitmq_instance.test.id
+  vhost            = sbercloud_dms_rabbitmq_vhost.test.name
+  exchange         = sbercloud_dms_rabbitmq_exchange.test.name
+  destination_type = "Queue"
+  destination      = sbercloud_dms_rabbitmq_queue.dead_letter.name
+  routing_key      = "expired"
+}
+`, testAccDmsRabbitmqQueue_basic(rName), rName)
+}
@@ -0,0 +1,76 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getDmsRabbitmqExchangeFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.DmsV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS client: %s", err)
+	}
+
+	listPath := client.Endpoint + fmt.Sprintf("v2/%s/rabbitmq/instances/%s/vhosts/%s/exchanges", client.ProjectID,
+		state.Primary.Attributes["instance_id"], encodeRabbitmqPathParam(state.Primary.Attributes["vhost"]))
+	return getRabbitmqItem(client, listPath, fmt.Sprintf("items[?name=='%s']|[0]", state.Primary.Attributes["name"]))
+}
+
+func TestAccDmsRabbitmqExchange_basic(t *testing.T) {
+	var exchange interface{}
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_dms_rabbitmq_exchange.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&exchange,
+		getDmsRabbitmqExchangeFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRabbitmqExchange_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "vhost",
+						"sbercloud_dms_rabbitmq_vhost.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "direct"),
+					resource.TestCheckResourceAttr(resourceName, "durable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_delete", "false"),
+					resource.TestCheckResourceAttr(resourceName, "internal", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsRabbitmqExchange_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_dms_rabbitmq_exchange" "test" {
+  instance_id = sbercloud_dms_rabbitmq_instance.test.id
+  vhost       = sbercloud_dms_rabbitmq_vhost.test.name
+  name        = "%s"
+  type        = "direct"
+}
+`, testAccDmsRabbitmqVhost_basic(rName), rName)
+}
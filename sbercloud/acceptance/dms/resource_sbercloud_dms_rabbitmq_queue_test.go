@@ -0,0 +1,93 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getDmsRabbitmqQueueFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.DmsV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS client: %s", err)
+	}
+
+	getPath := client.Endpoint + fmt.Sprintf("v2/%s/rabbitmq/instances/%s/vhosts/%s/queues/%s", client.ProjectID,
+		state.Primary.Attributes["instance_id"], encodeRabbitmqPathParam(state.Primary.Attributes["vhost"]),
+		encodeRabbitmqPathParam(state.Primary.Attributes["name"]))
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccDmsRabbitmqQueue_basic(t *testing.T) {
+	var queue interface{}
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_dms_rabbitmq_queue.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&queue,
+		getDmsRabbitmqQueueFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRabbitmqQueue_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "durable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "message_ttl", "60000"),
+					resource.TestCheckResourceAttrPair(resourceName, "dead_letter_exchange",
+						"sbercloud_dms_rabbitmq_exchange.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_routing_key", "expired"),
+					resource.TestCheckResourceAttr(resourceName, "lazy_mode", "true"),
+					resource.TestCheckResourceAttr(resourceName, "messages", "0"),
+					resource.TestCheckResourceAttr(resourceName, "consumers", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsRabbitmqQueue_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_dms_rabbitmq_queue" "test" {
+  instance_id             = sbercloud_dms_rabbitmq_instance.test.id
+  vhost                   = sbercloud_dms_rabbitmq_vhost.test.name
+  name                    = "%s"
+  message_ttl             = 60000
+  dead_letter_exchange    = sbercloud_dms_rabbitmq_exchange.test.name
+  dead_letter_routing_key = "expired"
+  lazy_mode               = true
+}
+`, testAccDmsRabbitmqExchange_basic(rName), rName)
+}
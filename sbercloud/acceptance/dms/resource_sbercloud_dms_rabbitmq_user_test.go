@@ -0,0 +1,96 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getDmsRabbitmqUserFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.DmsV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS client: %s", err)
+	}
+
+	listPath := client.Endpoint + fmt.Sprintf("v2/%s/instances/%s/users", client.ProjectID,
+		state.Primary.Attributes["instance_id"])
+	return getRabbitmqItem(client, listPath,
+		fmt.Sprintf("items[?access_key=='%s']|[0]", state.Primary.Attributes["access_key"]))
+}
+
+func TestAccDmsRabbitmqUser_basic(t *testing.T) {
+	var user interface{}
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_dms_rabbitmq_user.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&user,
+		getDmsRabbitmqUserFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRabbitmqUser_basic(rName, "Rabbitmquser@123", ".*"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "access_key", "user_test"),
+					resource.TestCheckResourceAttr(resourceName, "vhosts.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "vhosts.*", map[string]string{
+						"conf":  ".*",
+						"write": ".*",
+						"read":  ".*",
+					}),
+				),
+			},
+			{
+				Config: testAccDmsRabbitmqUser_basic(rName, "Rabbitmquser@456", "^order.*"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "vhosts.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "vhosts.*", map[string]string{
+						"conf":  "^order.*",
+						"write": "^order.*",
+						"read":  ".*",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key"},
+			},
+		},
+	})
+}
+
+func testAccDmsRabbitmqUser_basic(rName, secretKey, permission string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_dms_rabbitmq_user" "test" {
+  instance_id = sbercloud_dms_rabbitmq_instance.test.id
+  access_key  = "user_test"
+  secret_key  = "%s"
+
+  vhosts {
+    vhost = sbercloud_dms_rabbitmq_vhost.test.name
+    conf  = "%s"
+    write = "%s"
+    read  = ".*"
+  }
+}
+`, testAccDmsRabbitmqVhost_basic(rName), secretKey, permission, permission)
+}
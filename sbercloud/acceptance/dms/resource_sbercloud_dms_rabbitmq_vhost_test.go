@@ -0,0 +1,101 @@
+package dms
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+// getRabbitmqItem searches the item from the first page of the RabbitMQ list APIs.
+func getRabbitmqItem(client *golangsdk.ServiceClient, listPath, expression string) (interface{}, error) {
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", listPath+"?offset=0&limit=50", &listOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	item := utils.PathSearch(expression, respBody, nil)
+	if item == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return item, nil
+}
+
+func encodeRabbitmqPathParam(param string) string {
+	param = strings.ReplaceAll(param, "/", "__F_SLASH__")
+	return strings.ReplaceAll(param, "\\", "__B_SLASH__")
+}
+
+func getDmsRabbitmqVhostFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.DmsV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS client: %s", err)
+	}
+
+	listPath := client.Endpoint + fmt.Sprintf("v2/%s/rabbitmq/instances/%s/vhosts", client.ProjectID,
+		state.Primary.Attributes["instance_id"])
+	return getRabbitmqItem(client, listPath, fmt.Sprintf("items[?name=='%s']|[0]", state.Primary.Attributes["name"]))
+}
+
+func TestAccDmsRabbitmqVhost_basic(t *testing.T) {
+	var vhost interface{}
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_dms_rabbitmq_vhost.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&vhost,
+		getDmsRabbitmqVhostFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRabbitmqVhost_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"sbercloud_dms_rabbitmq_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "/"+rName),
+					resource.TestCheckResourceAttrSet(resourceName, "tracing"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsRabbitmqVhost_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_dms_rabbitmq_vhost" "test" {
+  instance_id = sbercloud_dms_rabbitmq_instance.test.id
+  name        = "/%s"
+}
+`, testAccDmsRabbitmqInstance_basic(rName), rName)
+}
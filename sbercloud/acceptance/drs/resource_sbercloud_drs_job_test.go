@@ -0,0 +1,175 @@
+package drs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/drs/v3/jobs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getDrsJobResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.DrsV3Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DRS v3 client: %s", err)
+	}
+	return jobs.Get(client, jobs.QueryJobReq{Jobs: []string{state.Primary.ID}})
+}
+
+func TestAccDrsJob_basic(t *testing.T) {
+	var obj jobs.BatchCreateJobReq
+	resourceName := "sbercloud_drs_job.test"
+	name := acceptance.RandomAccResourceName()
+	dbName := acceptance.RandomAccResourceName()
+	updateName := acceptance.RandomAccResourceName()
+	pwd := "TestDrs@123"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDrsJobResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDrsJob_migrate_mysql(name, dbName, pwd),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "type", "migration"),
+					resource.TestCheckResourceAttr(resourceName, "direction", "up"),
+					resource.TestCheckResourceAttr(resourceName, "net_type", "eip"),
+					resource.TestCheckResourceAttr(resourceName, "migration_type", "FULL_INCR_TRANS"),
+					resource.TestCheckResourceAttr(resourceName, "description", name),
+					resource.TestCheckResourceAttr(resourceName, "source_db.0.engine_type", "mysql"),
+					resource.TestCheckResourceAttr(resourceName, "source_db.0.ip", "192.168.0.58"),
+					resource.TestCheckResourceAttr(resourceName, "source_db.0.port", "3306"),
+					resource.TestCheckResourceAttr(resourceName, "destination_db.0.engine_type", "mysql"),
+					resource.TestCheckResourceAttr(resourceName, "destination_db.0.ip", "192.168.0.59"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_db.0.instance_id",
+						"sbercloud_rds_instance.test2", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				Config: testAccDrsJob_migrate_mysql(updateName, dbName, pwd),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", updateName),
+					resource.TestCheckResourceAttr(resourceName, "description", updateName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{"source_db.0.password", "destination_db.0.password",
+					"expired_days", "migrate_definer", "force_destroy"},
+			},
+		},
+	})
+}
+
+func testAccDrsJob_mysql(index int, name, pwd, ip string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_rds_instance" "test%d" {
+  depends_on = [
+    sbercloud_networking_secgroup_rule.ingress,
+    sbercloud_networking_secgroup_rule.egress,
+  ]
+  name              = "%s%d"
+  flavor            = "rds.mysql.c6.large.2"
+  security_group_id = sbercloud_networking_secgroup.test.id
+  subnet_id         = sbercloud_vpc_subnet.test.id
+  vpc_id            = sbercloud_vpc.test.id
+  fixed_ip          = "%s"
+  availability_zone = [data.sbercloud_availability_zones.test.names[0]]
+
+  db {
+    password = "%s"
+    type     = "MySQL"
+    version  = "5.7"
+    port     = 3306
+  }
+
+  volume {
+    type = "HIGH"
+    size = 40
+  }
+}
+`, index, name, index, ip, pwd)
+}
+
+func testAccDrsJob_migrate_mysql(name, dbName, pwd string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_networking_secgroup_rule" "ingress" {
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  ports             = 3306
+  protocol          = "tcp"
+  remote_ip_prefix  = "0.0.0.0/0"
+  security_group_id = sbercloud_networking_secgroup.test.id
+}
+
+resource "sbercloud_networking_secgroup_rule" "egress" {
+  direction         = "egress"
+  ethertype         = "IPv4"
+  protocol          = "tcp"
+  remote_ip_prefix  = "0.0.0.0/0"
+  security_group_id = sbercloud_networking_secgroup.test.id
+}
+
+data "sbercloud_availability_zones" "test" {}
+
+%s
+%s
+
+resource "sbercloud_drs_job" "test" {
+  name           = "%s"
+  type           = "migration"
+  engine_type    = "mysql"
+  direction      = "up"
+  net_type       = "eip"
+  migration_type = "FULL_INCR_TRANS"
+  description    = "%s"
+  force_destroy  = true
+
+  source_db {
+    engine_type = "mysql"
+    ip          = sbercloud_rds_instance.test1.fixed_ip
+    port        = 3306
+    user        = "root"
+    password    = "%s"
+  }
+
+  destination_db {
+    region      = sbercloud_rds_instance.test2.region
+    ip          = sbercloud_rds_instance.test2.fixed_ip
+    port        = 3306
+    engine_type = "mysql"
+    user        = "root"
+    password    = "%s"
+    instance_id = sbercloud_rds_instance.test2.id
+    subnet_id   = sbercloud_rds_instance.test2.subnet_id
+  }
+
+  lifecycle {
+    ignore_changes = [
+      source_db.0.password, destination_db.0.password, force_destroy,
+    ]
+  }
+}
+`, acceptance.TestBaseNetwork(name), testAccDrsJob_mysql(1, dbName, pwd, "192.168.0.58"),
+		testAccDrsJob_mysql(2, dbName, pwd, "192.168.0.59"), name, name, pwd, pwd)
+}
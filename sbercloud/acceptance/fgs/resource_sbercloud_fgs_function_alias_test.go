@@ -0,0 +1,140 @@
+package fgs
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/fgs/v2/aliases"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getFunctionAliasResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.FgsV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud FunctionGraph v2 client: %s", err)
+	}
+
+	parts := strings.SplitN(state.Primary.ID, "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid ID format: %s", state.Primary.ID)
+	}
+	return aliases.Get(c, parts[0], parts[1])
+}
+
+func TestAccFunctionGraphFunctionAlias_canary(t *testing.T) {
+	var alias aliases.Alias
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_fgs_function_alias.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&alias,
+		getFunctionAliasResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionGraphFunctionAlias_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", "stable"),
+					resource.TestCheckResourceAttrPair(resourceName, "version",
+						"sbercloud_fgs_function_version.v1", "version"),
+					resource.TestCheckResourceAttr(resourceName, "additional_version_weights.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "urn"),
+					resource.TestCheckResourceAttr("sbercloud_fgs_function_version.v1", "version", "v1"),
+					resource.TestCheckResourceAttrSet("sbercloud_fgs_function_version.v1", "digest"),
+				),
+			},
+			{
+				Config: testAccFunctionGraphFunctionAlias_canary(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "version", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "canary release of v2"),
+					resource.TestCheckResourceAttr(resourceName, "additional_version_weights.v2", "20"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sbercloud_fgs_function_version.v2",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"description",
+				},
+			},
+		},
+	})
+}
+
+func testAccFunctionGraphFunctionAlias_base(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_fgs_function" "test" {
+  name        = "%s"
+  app         = "default"
+  handler     = "index.handler"
+  memory_size = 128
+  timeout     = 10
+  runtime     = "Python2.7"
+  code_type   = "inline"
+  func_code   = "aW1wb3J0IGpzb24KZGVmIGhhbmRsZXIgKGZW50LCBjb250ZXh0KToKICAgIG91dHB1dCA9ICdIZWxsbyBtZXNzYWdlOiAnICsganNvbi5kdW1wcyhldmVudCkKICAgIHJldHVybiBvdXRwdXQ="
+}
+
+resource "sbercloud_fgs_function_version" "v1" {
+  function_urn = sbercloud_fgs_function.test.urn
+  version      = "v1"
+  description  = "first release"
+}
+`, rName)
+}
+
+func testAccFunctionGraphFunctionAlias_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_fgs_function_alias" "test" {
+  function_urn = sbercloud_fgs_function.test.urn
+  name         = "stable"
+  version      = sbercloud_fgs_function_version.v1.version
+}
+`, testAccFunctionGraphFunctionAlias_base(rName))
+}
+
+func testAccFunctionGraphFunctionAlias_canary(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_fgs_function_version" "v2" {
+  function_urn = sbercloud_fgs_function.test.urn
+  version      = "v2"
+  description  = "second release"
+
+  depends_on = [sbercloud_fgs_function_version.v1]
+}
+
+resource "sbercloud_fgs_function_alias" "test" {
+  function_urn = sbercloud_fgs_function.test.urn
+  name         = "stable"
+  version      = sbercloud_fgs_function_version.v1.version
+  description  = "canary release of v2"
+
+  additional_version_weights = {
+    (sbercloud_fgs_function_version.v2.version) = 20
+  }
+}
+`, testAccFunctionGraphFunctionAlias_base(rName))
+}
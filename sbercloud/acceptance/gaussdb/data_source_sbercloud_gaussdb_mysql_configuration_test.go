@@ -0,0 +1,30 @@
+package gaussdb
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccGaussdbMysqlConfigurationDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGaussdbMysqlConfigurationDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.sbercloud_gaussdb_mysql_configuration.test", "name", "Default-GaussDB-for-MySQL 8.0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccGaussdbMysqlConfigurationDataSource_basic = `
+data "sbercloud_gaussdb_mysql_configuration" "test" {
+  name = "Default-GaussDB-for-MySQL 8.0"
+}
+`
@@ -0,0 +1,71 @@
+package gaussdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils/fmtp"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGaussdbMysqlInstancesDataSource_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGaussdbMysqlInstancesDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGaussdbMysqlInstancesDataSourceID("data.sbercloud_gaussdb_mysql_instances.test"),
+					resource.TestCheckResourceAttr("data.sbercloud_gaussdb_mysql_instances.test", "instances.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGaussdbMysqlInstancesDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmtp.Errorf("Can't find GaussDB mysql instance data source: %s ", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmtp.Errorf("GaussDB mysql instances data source ID not set ")
+		}
+
+		return nil
+	}
+}
+
+func testAccGaussdbMysqlInstancesDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_gaussdb_mysql_instance" "test" {
+  name                  = "%s"
+  password              = "Test@12345678"
+  flavor                = "gaussdb.mysql.2xlarge.x86.4"
+  vpc_id                = sbercloud_vpc.test.id
+  subnet_id             = sbercloud_vpc_subnet.test.id
+  security_group_id     = sbercloud_networking_secgroup.test.id
+  enterprise_project_id = "0"
+}
+
+data "sbercloud_gaussdb_mysql_instances" "test" {
+  name = sbercloud_gaussdb_mysql_instance.test.name
+  depends_on = [
+    sbercloud_gaussdb_mysql_instance.test,
+  ]
+}
+`, acceptance.TestBaseNetwork(rName), rName)
+}
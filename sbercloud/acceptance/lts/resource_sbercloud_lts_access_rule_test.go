@@ -0,0 +1,129 @@
+package lts
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getAccessRuleResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("lts", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating LTS client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v2/{project_id}/lts/aom-mapping/" + state.Primary.ID
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	rule := utils.PathSearch("[0]", respBody, nil)
+	if rule == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return rule, nil
+}
+
+func TestAccLtsAccessRule_basic(t *testing.T) {
+	var obj interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_lts_access_rule.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getAccessRuleResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLtsAccessRule_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", "sbercloud_cce_cluster.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "name_space", "default"),
+					resource.TestCheckResourceAttr(resourceName, "files.0.file_name", "__ALL_FILES__"),
+					resource.TestCheckResourceAttrPair(resourceName, "files.0.log_stream_info.0.target_log_stream_id",
+						"sbercloud_lts_stream.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"files"},
+			},
+		},
+	})
+}
+
+func testAccLtsAccessRule_basic(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "sbercloud_cce_cluster" "test" {
+  name                   = "%[2]s"
+  flavor_id              = "cce.s1.small"
+  vpc_id                 = sbercloud_vpc.test.id
+  subnet_id              = sbercloud_vpc_subnet.test.id
+  container_network_type = "overlay_l2"
+}
+
+resource "sbercloud_lts_group" "test" {
+  group_name  = "%[2]s"
+  ttl_in_days = 1
+}
+
+resource "sbercloud_lts_stream" "test" {
+  group_id    = sbercloud_lts_group.test.id
+  stream_name = "%[2]s"
+}
+
+resource "sbercloud_lts_access_rule" "test" {
+  rule_name    = "%[2]s"
+  cluster_id   = sbercloud_cce_cluster.test.id
+  cluster_name = sbercloud_cce_cluster.test.name
+  name_space   = "default"
+  deployments  = ["__ALL_DEPLOYMENTS__"]
+
+  files {
+    file_name = "__ALL_FILES__"
+
+    log_stream_info {
+      target_log_group_id    = sbercloud_lts_group.test.id
+      target_log_group_name  = sbercloud_lts_group.test.group_name
+      target_log_stream_id   = sbercloud_lts_stream.test.id
+      target_log_stream_name = sbercloud_lts_stream.test.stream_name
+    }
+  }
+}
+`, acceptance.TestVpc(rName), rName)
+}
@@ -0,0 +1,106 @@
+package lts
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getElbLogResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("elb", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ELB client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v3/{project_id}/elb/logtanks/" + state.Primary.ID
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccLtsElbLog_basic(t *testing.T) {
+	var obj interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_elb_log.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getElbLogResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLtsElbLog_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "loadbalancer_id",
+						"sbercloud_elb_loadbalancer.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_group_id", "sbercloud_lts_group.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_topic_id", "sbercloud_lts_stream.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLtsElbLog_basic(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_elb_loadbalancer" "test" {
+  name           = "%[2]s"
+  ipv4_subnet_id = sbercloud_vpc_subnet.test.ipv4_subnet_id
+
+  availability_zone = [
+    data.sbercloud_availability_zones.test.names[0]
+  ]
+}
+
+resource "sbercloud_lts_group" "test" {
+  group_name  = "%[2]s"
+  ttl_in_days = 1
+}
+
+resource "sbercloud_lts_stream" "test" {
+  group_id    = sbercloud_lts_group.test.id
+  stream_name = "%[2]s"
+}
+
+resource "sbercloud_elb_log" "test" {
+  loadbalancer_id = sbercloud_elb_loadbalancer.test.id
+  log_group_id    = sbercloud_lts_group.test.id
+  log_topic_id    = sbercloud_lts_stream.test.id
+}
+`, acceptance.TestVpc(rName), rName)
+}
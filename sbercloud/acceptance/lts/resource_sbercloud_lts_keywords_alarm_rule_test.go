@@ -0,0 +1,162 @@
+package lts
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getKeywordsAlarmRuleResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("lts", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating LTS client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v2/{project_id}/lts/alarms/keywords-alarm-rule"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	rule := utils.PathSearch(
+		fmt.Sprintf("keywords_alarm_rules[?keywords_alarm_rule_id=='%s']|[0]", state.Primary.ID), respBody, nil)
+	if rule == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return rule, nil
+}
+
+func TestAccLtsKeywordsAlarmRule_basic(t *testing.T) {
+	var obj interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_lts_keywords_alarm_rule.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getKeywordsAlarmRuleResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLtsKeywordsAlarmRule_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_level", "Major"),
+					resource.TestCheckResourceAttr(resourceName, "keywords_requests.0.keywords", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "keywords_requests.0.number", "10"),
+					resource.TestCheckResourceAttr(resourceName, "frequency.0.type", "FIXED_RATE"),
+					resource.TestCheckResourceAttr(resourceName, "frequency.0.fixed_rate", "5"),
+				),
+			},
+			{
+				Config: testAccLtsKeywordsAlarmRule_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by terraform"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_level", "Critical"),
+					resource.TestCheckResourceAttr(resourceName, "keywords_requests.0.condition", ">"),
+					resource.TestCheckResourceAttr(resourceName, "frequency.0.type", "DAILY"),
+					resource.TestCheckResourceAttr(resourceName, "frequency.0.hour_of_day", "8"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLtsKeywordsAlarmRule_base(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_lts_group" "test" {
+  group_name  = "%[1]s"
+  ttl_in_days = 1
+}
+
+resource "sbercloud_lts_stream" "test" {
+  group_id    = sbercloud_lts_group.test.id
+  stream_name = "%[1]s"
+}
+`, rName)
+}
+
+func testAccLtsKeywordsAlarmRule_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_lts_keywords_alarm_rule" "test" {
+  name        = "%s"
+  alarm_level = "Major"
+
+  keywords_requests {
+    log_group_id      = sbercloud_lts_group.test.id
+    log_stream_id     = sbercloud_lts_stream.test.id
+    keywords          = "ERROR"
+    condition         = ">="
+    number            = 10
+    search_time_range = 5
+  }
+
+  frequency {
+    type            = "FIXED_RATE"
+    fixed_rate      = 5
+    fixed_rate_unit = "minute"
+  }
+}
+`, testAccLtsKeywordsAlarmRule_base(rName), rName)
+}
+
+func testAccLtsKeywordsAlarmRule_update(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_lts_keywords_alarm_rule" "test" {
+  name        = "%s"
+  description = "updated by terraform"
+  alarm_level = "Critical"
+
+  keywords_requests {
+    log_group_id      = sbercloud_lts_group.test.id
+    log_stream_id     = sbercloud_lts_stream.test.id
+    keywords          = "ERROR"
+    condition         = ">"
+    number            = 20
+    search_time_range = 1
+  }
+
+  frequency {
+    type        = "DAILY"
+    hour_of_day = 8
+  }
+}
+`, testAccLtsKeywordsAlarmRule_base(rName), rName)
+}
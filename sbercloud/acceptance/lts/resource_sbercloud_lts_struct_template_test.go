@@ -0,0 +1,133 @@
+package lts
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getLtsStructTemplateFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("lts", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating LTS client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v2/{project_id}/lts/struct/template?logGroupId={log_group_id}&logStreamId={log_stream_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{log_group_id}", state.Primary.Attributes["log_group_id"])
+	getPath = strings.ReplaceAll(getPath, "{log_stream_id}", state.Primary.Attributes["log_stream_id"])
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// the template is returned as a JSON document encoded in a JSON string
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var encoded string
+	if err = json.Unmarshal(body, &encoded); err != nil {
+		return nil, fmt.Errorf("error parsing the struct template: %s", err)
+	}
+	var template interface{}
+	if err = json.Unmarshal([]byte(encoded), &template); err != nil {
+		return nil, fmt.Errorf("error parsing the struct template: %s", err)
+	}
+	if utils.PathSearch("id", template, "").(string) == "" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return template, nil
+}
+
+func TestAccLtsStructTemplate_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_lts_struct_template.template_1"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getLtsStructTemplateFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: tesLtsStructTemplate_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "log_group_id",
+						"sbercloud_lts_group.group_1", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_stream_id",
+						"sbercloud_lts_stream.stream_1", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "demo_log"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_type"},
+				ImportStateIdFunc:       testAccLtsStructImportStateIdFunc(),
+			},
+		},
+	})
+}
+
+func testAccLtsStructImportStateIdFunc() resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		var logGroupId, logStreamId, id string
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type == "sbercloud_lts_struct_template" {
+				logGroupId = rs.Primary.Attributes["log_group_id"]
+				logStreamId = rs.Primary.Attributes["log_stream_id"]
+				id = rs.Primary.ID
+			}
+		}
+		if logGroupId == "" || logStreamId == "" || id == "" {
+			return "", fmt.Errorf("resource not found: %s/%s/%s", id, logGroupId, logStreamId)
+		}
+		return fmt.Sprintf("%s/%s/%s", id, logGroupId, logStreamId), nil
+	}
+}
+
+func tesLtsStructTemplate_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_lts_group" "group_1" {
+  group_name  = "%[1]s"
+  ttl_in_days = 1
+}
+
+resource "sbercloud_lts_stream" "stream_1" {
+  group_id    = sbercloud_lts_group.group_1.id
+  stream_name = "%[1]s"
+}
+
+resource "sbercloud_lts_struct_template" "template_1" {
+  log_group_id  = sbercloud_lts_group.group_1.id
+  log_stream_id = sbercloud_lts_stream.stream_1.id
+  template_type = "custom"
+}`, rName)
+}
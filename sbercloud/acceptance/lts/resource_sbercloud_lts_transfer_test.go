@@ -0,0 +1,141 @@
+package lts
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getLtsTransferResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("lts", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating LTS client: %s", err)
+	}
+
+	getPath := client.Endpoint + strings.ReplaceAll("v2/{project_id}/transfers", "{project_id}", client.ProjectID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	transfer := utils.PathSearch(fmt.Sprintf("log_transfers[?log_transfer_id=='%s']|[0]", state.Primary.ID),
+		respBody, nil)
+	if transfer == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return transfer, nil
+}
+
+func TestAccLtsTransfer_basic(t *testing.T) {
+	var obj interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_lts_transfer.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getLtsTransferResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLtsTransfer_basic(rName, "ENABLE", 3),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "log_group_id", "sbercloud_lts_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "log_group_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "log_streams.0.log_stream_id",
+						"sbercloud_lts_stream.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "log_transfer_info.0.log_transfer_type", "OBS"),
+					resource.TestCheckResourceAttr(resourceName, "log_transfer_info.0.log_transfer_mode", "cycle"),
+					resource.TestCheckResourceAttr(resourceName, "log_transfer_info.0.log_transfer_status", "ENABLE"),
+					resource.TestCheckResourceAttr(resourceName,
+						"log_transfer_info.0.log_transfer_detail.0.obs_bucket_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "log_transfer_info.0.log_transfer_detail.0.obs_period",
+						"3"),
+				),
+			},
+			{
+				Config: testAccLtsTransfer_basic(rName, "DISABLE", 6),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "log_transfer_info.0.log_transfer_status", "DISABLE"),
+					resource.TestCheckResourceAttr(resourceName, "log_transfer_info.0.log_transfer_detail.0.obs_period",
+						"6"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLtsTransfer_basic(rName, status string, period int) string {
+	return fmt.Sprintf(`
+resource "sbercloud_obs_bucket" "test" {
+  bucket        = "%[1]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "sbercloud_lts_group" "test" {
+  group_name  = "%[1]s"
+  ttl_in_days = 1
+}
+
+resource "sbercloud_lts_stream" "test" {
+  group_id    = sbercloud_lts_group.test.id
+  stream_name = "%[1]s"
+}
+
+resource "sbercloud_lts_transfer" "test" {
+  log_group_id = sbercloud_lts_group.test.id
+
+  log_streams {
+    log_stream_id   = sbercloud_lts_stream.test.id
+    log_stream_name = sbercloud_lts_stream.test.stream_name
+  }
+
+  log_transfer_info {
+    log_transfer_type   = "OBS"
+    log_transfer_mode   = "cycle"
+    log_storage_format  = "RAW"
+    log_transfer_status = "%[2]s"
+
+    log_transfer_detail {
+      obs_period          = %[3]d
+      obs_period_unit     = "hour"
+      obs_bucket_name     = sbercloud_obs_bucket.test.bucket
+      obs_dir_prefix_name = "lts"
+    }
+  }
+}
+`, rName, status, period)
+}
@@ -0,0 +1,90 @@
+package obs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccObsBucketObjectsDataSource_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceNameWithDash()
+	dataSourceName := "data.sbercloud_obs_bucket_objects.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+	byDelimiter := "data.sbercloud_obs_bucket_objects.delimiter"
+	dcByDelimiter := acceptance.InitDataSourceCheck(byDelimiter)
+	byMaxKeys := "data.sbercloud_obs_bucket_objects.max_keys"
+	dcByMaxKeys := acceptance.InitDataSourceCheck(byMaxKeys)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketObjectsDataSource_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0", "logs/a.txt"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.1", "logs/b.txt"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.size", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.storage_class", "STANDARD"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.etag"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.last_modified"),
+					dcByDelimiter.CheckResourceExists(),
+					resource.TestCheckResourceAttr(byDelimiter, "keys.#", "1"),
+					resource.TestCheckResourceAttr(byDelimiter, "keys.0", "index.html"),
+					resource.TestCheckResourceAttr(byDelimiter, "common_prefixes.#", "1"),
+					resource.TestCheckResourceAttr(byDelimiter, "common_prefixes.0", "logs/"),
+					dcByMaxKeys.CheckResourceExists(),
+					resource.TestCheckResourceAttr(byMaxKeys, "keys.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccObsBucketObjectsDataSource_basic(name string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_obs_bucket" "test" {
+  bucket        = "%s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "sbercloud_obs_bucket_object" "test" {
+  for_each = toset(["index.html", "logs/a.txt", "logs/b.txt"])
+
+  bucket  = sbercloud_obs_bucket.test.bucket
+  key     = each.key
+  content = "hello"
+}
+
+data "sbercloud_obs_bucket_objects" "test" {
+  bucket = sbercloud_obs_bucket.test.bucket
+  prefix = "logs/"
+
+  depends_on = [sbercloud_obs_bucket_object.test]
+}
+
+data "sbercloud_obs_bucket_objects" "delimiter" {
+  bucket    = sbercloud_obs_bucket.test.bucket
+  delimiter = "/"
+
+  depends_on = [sbercloud_obs_bucket_object.test]
+}
+
+data "sbercloud_obs_bucket_objects" "max_keys" {
+  bucket   = sbercloud_obs_bucket.test.bucket
+  max_keys = 1
+
+  depends_on = [sbercloud_obs_bucket_object.test]
+}
+`, name)
+}
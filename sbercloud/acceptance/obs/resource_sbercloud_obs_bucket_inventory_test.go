@@ -0,0 +1,122 @@
+package obs
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getObsBucketInventoryResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	parts := strings.SplitN(state.Primary.ID, "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid ID format, want '<bucket>/<name>', but got '%s'", state.Primary.ID)
+	}
+	return getBucketSubResource(cfg, parts[0], map[string]string{"inventory": "", "id": parts[1]})
+}
+
+func TestAccObsBucketInventory_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceNameWithDash()
+	rName := "sbercloud_obs_bucket_inventory.test"
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getObsBucketInventoryResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketInventory_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "bucket", name),
+					resource.TestCheckResourceAttr(rName, "name", "daily"),
+					resource.TestCheckResourceAttr(rName, "frequency", "Daily"),
+					resource.TestCheckResourceAttr(rName, "enabled", "true"),
+					resource.TestCheckResourceAttr(rName, "included_object_versions", "Current"),
+					resource.TestCheckResourceAttr(rName, "optional_fields.#", "2"),
+					resource.TestCheckResourceAttrPair(rName, "destination_bucket",
+						"sbercloud_obs_bucket.destination", "bucket"),
+				),
+			},
+			{
+				Config: testAccObsBucketInventory_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "frequency", "Weekly"),
+					resource.TestCheckResourceAttr(rName, "enabled", "false"),
+					resource.TestCheckResourceAttr(rName, "prefix", "logs/"),
+					resource.TestCheckResourceAttr(rName, "destination_prefix", "inventory/"),
+					resource.TestCheckResourceAttr(rName, "included_object_versions", "All"),
+					resource.TestCheckResourceAttr(rName, "optional_fields.#", "0"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccObsBucketInventory_base(name string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_obs_bucket" "test" {
+  bucket        = "%[1]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "sbercloud_obs_bucket" "destination" {
+  bucket        = "%[1]s-dest"
+  acl           = "private"
+  force_destroy = true
+}
+`, name)
+}
+
+func testAccObsBucketInventory_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_obs_bucket_inventory" "test" {
+  bucket             = sbercloud_obs_bucket.test.bucket
+  name               = "daily"
+  destination_bucket = sbercloud_obs_bucket.destination.bucket
+  frequency          = "Daily"
+  optional_fields    = ["Size", "LastModifiedDate"]
+}
+`, testAccObsBucketInventory_base(name))
+}
+
+func testAccObsBucketInventory_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_obs_bucket_inventory" "test" {
+  bucket                   = sbercloud_obs_bucket.test.bucket
+  name                     = "daily"
+  destination_bucket       = sbercloud_obs_bucket.destination.bucket
+  destination_prefix       = "inventory/"
+  frequency                = "Weekly"
+  enabled                  = false
+  prefix                   = "logs/"
+  included_object_versions = "All"
+}
+`, testAccObsBucketInventory_base(name))
+}
@@ -0,0 +1,129 @@
+package obs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getObsBucketNotificationResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	obsClient, err := cfg.ObjectStorageClientWithSignature(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OBS Client: %s", err)
+	}
+
+	output, err := obsClient.GetBucketNotification(state.Primary.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(output.TopicConfigurations) == 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return output, nil
+}
+
+func TestAccObsBucketNotification_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceNameWithDash()
+	rName := "sbercloud_obs_bucket_notification.test"
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getObsBucketNotificationResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketNotification_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "bucket", name),
+					resource.TestCheckResourceAttr(rName, "smn_topic.#", "1"),
+					resource.TestCheckResourceAttr(rName, "smn_topic.0.id", "upload"),
+					resource.TestCheckResourceAttr(rName, "smn_topic.0.events.#", "1"),
+					resource.TestCheckResourceAttr(rName, "smn_topic.0.prefix", "images/"),
+					resource.TestCheckResourceAttrPair(rName, "smn_topic.0.urn", "sbercloud_smn_topic.test", "topic_urn"),
+				),
+			},
+			{
+				Config: testAccObsBucketNotification_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "smn_topic.#", "1"),
+					resource.TestCheckResourceAttr(rName, "smn_topic.0.id", "upload-and-delete"),
+					resource.TestCheckResourceAttr(rName, "smn_topic.0.events.#", "2"),
+					resource.TestCheckResourceAttr(rName, "smn_topic.0.prefix", ""),
+					resource.TestCheckResourceAttr(rName, "smn_topic.0.suffix", ".jpg"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccObsBucketNotification_base(name string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_obs_bucket" "test" {
+  bucket        = "%[1]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "sbercloud_smn_topic" "test" {
+  name = "%[2]s"
+}
+`, name, acceptance.RandomAccResourceName())
+}
+
+func testAccObsBucketNotification_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_obs_bucket_notification" "test" {
+  bucket = sbercloud_obs_bucket.test.bucket
+
+  smn_topic {
+    id     = "upload"
+    urn    = sbercloud_smn_topic.test.topic_urn
+    events = ["ObjectCreated:*"]
+    prefix = "images/"
+  }
+}
+`, testAccObsBucketNotification_base(name))
+}
+
+func testAccObsBucketNotification_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_obs_bucket_notification" "test" {
+  bucket = sbercloud_obs_bucket.test.bucket
+
+  smn_topic {
+    id     = "upload-and-delete"
+    urn    = sbercloud_smn_topic.test.topic_urn
+    events = ["ObjectCreated:Put", "ObjectRemoved:Delete"]
+    suffix = ".jpg"
+  }
+}
+`, testAccObsBucketNotification_base(name))
+}
@@ -0,0 +1,127 @@
+package obs
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+// getBucketSubResource queries the bucket sub-resource which is not supported by the SDK with a V4 signed URL.
+func getBucketSubResource(cfg *config.Config, bucket string, params map[string]string) (string, error) {
+	endpoint := fmt.Sprintf("https://obs.%s.%s/", acceptance.SBC_REGION_NAME, cfg.Cloud)
+	if customEndpoint, ok := cfg.Endpoints["obs"]; ok {
+		endpoint = customEndpoint
+	}
+	client, err := obs.New(cfg.AccessKey, cfg.SecretKey, endpoint, obs.WithSignature(obs.SignatureV4),
+		obs.WithRegion(acceptance.SBC_REGION_NAME), obs.WithSecurityToken(cfg.SecurityToken))
+	if err != nil {
+		return "", fmt.Errorf("error creating OBS Client: %s", err)
+	}
+
+	signedUrl, err := client.CreateSignedUrl(&obs.CreateSignedUrlInput{
+		Method:      obs.HttpMethodGet,
+		Bucket:      bucket,
+		QueryParams: params,
+	})
+	if err != nil {
+		return "", err
+	}
+	output, err := client.GetBucketPolicyWithSignedUrl(signedUrl.SignedUrl, signedUrl.ActualSignedRequestHeaders)
+	if err != nil {
+		return "", err
+	}
+	return output.Policy, nil
+}
+
+func getObsBucketObjectLockResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getBucketSubResource(cfg, state.Primary.ID, map[string]string{"object-lock": ""})
+}
+
+func TestAccObsBucketObjectLock_basic(t *testing.T) {
+	var obj interface{}
+
+	bucketName := acceptance.RandomAccResourceNameWithDash()
+	rName := "sbercloud_obs_bucket_object_lock.test"
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getObsBucketObjectLockResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketObjectLock_basic(bucketName, "days = 1"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(rName, "mode", "COMPLIANCE"),
+					resource.TestCheckResourceAttr(rName, "days", "1"),
+					resource.TestCheckResourceAttr(rName, "years", "0"),
+					testAccCheckObsBucketObjectLockContains(rName, "<Days>1</Days>"),
+				),
+			},
+			{
+				Config: testAccObsBucketObjectLock_basic(bucketName, "years = 1"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "days", "0"),
+					resource.TestCheckResourceAttr(rName, "years", "1"),
+					testAccCheckObsBucketObjectLockContains(rName, "<Years>1</Years>"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckObsBucketObjectLockContains(rName, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return fmt.Errorf("resource (%s) not found", rName)
+		}
+		cfg := acceptance.TestAccProvider.Meta().(*config.Config)
+		body, err := getBucketSubResource(cfg, rs.Primary.ID, map[string]string{"object-lock": ""})
+		if err != nil {
+			return err
+		}
+		if !strings.Contains(body, expected) {
+			return fmt.Errorf("the object lock configuration %s does not contain %s", body, expected)
+		}
+		return nil
+	}
+}
+
+func testAccObsBucketObjectLock_basic(bucketName, retention string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_obs_bucket" "test" {
+  bucket        = "%s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "sbercloud_obs_bucket_object_lock" "test" {
+  bucket = sbercloud_obs_bucket.test.bucket
+  %s
+}
+`, bucketName, retention)
+}
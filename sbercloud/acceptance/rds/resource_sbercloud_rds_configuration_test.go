@@ -40,6 +40,21 @@ func TestAccRdsConfigurationV3_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", "description_update"),
 				),
 			},
+			{
+				Config: testAccRdsConfigV3_values(updateName, "20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsConfigV3Exists(resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "values.max_connections", "20"),
+				),
+			},
+			{
+				Config: testAccRdsConfigV3_values(updateName, "30"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsConfigV3Exists(resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "values.max_connections", "30"),
+					resource.TestCheckResourceAttr(resourceName, "restart_required_parameters.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -124,3 +139,21 @@ resource "sbercloud_rds_parametergroup" "pg_1" {
 }
 `, updateName)
 }
+
+func testAccRdsConfigV3_values(updateName, maxConnections string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_rds_parametergroup" "pg_1" {
+  name        = "%s"
+  description = "description_update"
+
+  values = {
+    max_connections = "%s"
+  }
+
+  datastore {
+    type    = "mysql"
+    version = "5.6"
+  }
+}
+`, updateName, maxConnections)
+}
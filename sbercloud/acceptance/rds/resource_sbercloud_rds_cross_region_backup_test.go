@@ -0,0 +1,132 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	model "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccRdsCrossRegionBackup_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_rds_cross_region_backup.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDestProject(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckRdsCrossRegionBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testRdsCrossRegionBackup_basic(rName, "auto", 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsCrossRegionBackupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup_type", "auto"),
+					resource.TestCheckResourceAttr(resourceName, "keep_days", "5"),
+					resource.TestCheckResourceAttr(resourceName, "destination_region", acceptance.SBC_DEST_REGION),
+					resource.TestCheckResourceAttr(resourceName, "destination_project_id",
+						acceptance.SBC_DEST_PROJECT_ID),
+				),
+			},
+			{
+				Config: testRdsCrossRegionBackup_basic(rName, "all", 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsCrossRegionBackupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup_type", "all"),
+					resource.TestCheckResourceAttr(resourceName, "keep_days", "8"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRdsCrossRegionBackupEnabled(policies *[]model.GetOffSiteBackupPolicy) bool {
+	if policies == nil {
+		return false
+	}
+	for _, p := range *policies {
+		if p.KeepDays != nil && *p.KeepDays != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func testAccCheckRdsCrossRegionBackupDestroy(s *terraform.State) error {
+	c := acceptance.TestAccProvider.Meta().(*config.Config)
+	client, err := c.HcRdsV3Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_rds_cross_region_backup" {
+			continue
+		}
+
+		resp, err := client.ShowOffSiteBackupPolicy(&model.ShowOffSiteBackupPolicyRequest{InstanceId: rs.Primary.ID})
+		if err != nil {
+			return nil
+		}
+		if testAccCheckRdsCrossRegionBackupEnabled(resp.PolicyPara) {
+			return fmt.Errorf("the cross-region backup of RDS instance (%s) is still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckRdsCrossRegionBackupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		c := acceptance.TestAccProvider.Meta().(*config.Config)
+		client, err := c.HcRdsV3Client(acceptance.SBC_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating RDS client: %s", err)
+		}
+
+		resp, err := client.ShowOffSiteBackupPolicy(&model.ShowOffSiteBackupPolicyRequest{InstanceId: rs.Primary.ID})
+		if err != nil {
+			return err
+		}
+		if !testAccCheckRdsCrossRegionBackupEnabled(resp.PolicyPara) {
+			return fmt.Errorf("the cross-region backup of RDS instance (%s) is disabled", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testRdsCrossRegionBackup_basic(rName, backupType string, keepDays int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_rds_cross_region_backup" "test" {
+  instance_id            = sbercloud_rds_instance.test.id
+  backup_type            = "%s"
+  keep_days              = %d
+  destination_region     = "%s"
+  destination_project_id = "%s"
+}
+`, testRdsAccount_base(rName), backupType, keepDays, acceptance.SBC_DEST_REGION, acceptance.SBC_DEST_PROJECT_ID)
+}
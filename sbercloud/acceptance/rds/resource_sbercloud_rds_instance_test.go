@@ -49,6 +49,8 @@ func TestAccRdsInstanceV3_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "backup_strategy.0.keep_days", "2"),
 					resource.TestCheckResourceAttr(resourceName, "flavor", "rds.pg.c6.xlarge.4"),
 					resource.TestCheckResourceAttr(resourceName, "volume.0.size", "100"),
+					resource.TestCheckResourceAttr(resourceName, "volume.0.limit_size", "200"),
+					resource.TestCheckResourceAttr(resourceName, "volume.0.trigger_threshold", "10"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value"),
 					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar_updated"),
 					resource.TestCheckResourceAttr(resourceName, "charging_mode", "postPaid"),
@@ -270,7 +272,7 @@ resource "sbercloud_rds_instance" "test" {
 `, testAccRdsInstanceV3_base(name), name)
 }
 
-// volume.size, backup_strategy, flavor and tags will be updated
+// volume.size, volume auto-expansion, backup_strategy, flavor and tags will be updated
 func testAccRdsInstanceV3_update(name string) string {
 	return fmt.Sprintf(`
 %s
@@ -291,8 +293,10 @@ resource "sbercloud_rds_instance" "test" {
     port     = 8635
   }
   volume {
-    type = "HIGH"
-    size = 100
+    type              = "HIGH"
+    size              = 100
+    limit_size        = 200
+    trigger_threshold = 10
   }
   backup_strategy {
     start_time = "09:00-10:00"
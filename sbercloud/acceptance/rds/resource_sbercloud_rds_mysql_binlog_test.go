@@ -0,0 +1,111 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	model "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccRdsMysqlBinlog_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_rds_mysql_binlog.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckRdsMysqlBinlogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testRdsMysqlBinlog_basic(rName, 6),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsMysqlBinlogExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"sbercloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "binlog_retention_hours", "6"),
+				),
+			},
+			{
+				Config: testRdsMysqlBinlog_basic(rName, 12),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsMysqlBinlogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "binlog_retention_hours", "12"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRdsMysqlBinlogDestroy(s *terraform.State) error {
+	c := acceptance.TestAccProvider.Meta().(*config.Config)
+	client, err := c.HcRdsV3Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_rds_mysql_binlog" {
+			continue
+		}
+
+		resp, err := client.ShowBinlogClearPolicy(&model.ShowBinlogClearPolicyRequest{InstanceId: rs.Primary.ID})
+		if err != nil {
+			return nil
+		}
+		if resp.BinlogRetentionHours != nil && *resp.BinlogRetentionHours != 0 {
+			return fmt.Errorf("the binlog retention hours of RDS instance (%s) are still set", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckRdsMysqlBinlogExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		c := acceptance.TestAccProvider.Meta().(*config.Config)
+		client, err := c.HcRdsV3Client(acceptance.SBC_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating RDS client: %s", err)
+		}
+
+		resp, err := client.ShowBinlogClearPolicy(&model.ShowBinlogClearPolicyRequest{InstanceId: rs.Primary.ID})
+		if err != nil {
+			return err
+		}
+		if resp.BinlogRetentionHours == nil || *resp.BinlogRetentionHours == 0 {
+			return fmt.Errorf("the binlog retention hours of RDS instance (%s) are not set", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testRdsMysqlBinlog_basic(rName string, hours int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_rds_mysql_binlog" "test" {
+  instance_id            = sbercloud_rds_instance.test.id
+  binlog_retention_hours = %d
+}
+`, testRdsAccount_base(rName), hours)
+}
@@ -0,0 +1,122 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	model "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+	rds2 "github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/rds"
+)
+
+func getRdsPgAccountFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcRdsV3Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and user from resource id
+	parts := strings.SplitN(state.Primary.ID, "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid id format, must be <instance_id>/<user>")
+	}
+	return rds2.QueryPgAccount(client, parts[0], parts[1])
+}
+
+func TestAccRdsPgAccount_basic(t *testing.T) {
+	var user model.PostgresqlUserForList
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_rds_pg_account.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&user,
+		getRdsPgAccountFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testRdsPgAccount_basic(rName, "Test@12345678", "created by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "sbercloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+				),
+			},
+			{
+				Config: testRdsPgAccount_basic(rName, "Test@123456789", "updated by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "password", "Test@123456789"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by acc test"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testRdsPgAccount_base(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "sbercloud_availability_zones" "test" {}
+
+data "sbercloud_rds_flavors" "test" {
+  db_type       = "PostgreSQL"
+  db_version    = "12"
+  instance_mode = "single"
+}
+
+resource "sbercloud_rds_instance" "test" {
+  name              = "%s"
+  flavor            = data.sbercloud_rds_flavors.test.flavors[0].name
+  security_group_id = sbercloud_networking_secgroup.test.id
+  subnet_id         = sbercloud_vpc_subnet.test.id
+  vpc_id            = sbercloud_vpc.test.id
+  availability_zone = [data.sbercloud_availability_zones.test.names[0]]
+
+  db {
+    password = "Test@12345678"
+    type     = "PostgreSQL"
+    version  = "12"
+    port     = 8635
+  }
+
+  volume {
+    type = "HIGH"
+    size = 50
+  }
+}
+`, acceptance.TestBaseNetwork(rName), rName)
+}
+
+func testRdsPgAccount_basic(rName, password, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_rds_pg_account" "test" {
+  instance_id = sbercloud_rds_instance.test.id
+  name        = "%s"
+  password    = "%s"
+  description = "%s"
+}
+`, testRdsPgAccount_base(rName), rName, password, description)
+}
@@ -0,0 +1,65 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccRdsPgDatabasePrivilege_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_rds_pg_database_privilege.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsPgDatabasePrivilege_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "sbercloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "db_name", "sbercloud_rds_pg_database.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the schema names are not returned by the API
+				ImportStateVerifyIgnore: []string{"users"},
+			},
+		},
+	})
+}
+
+func testAccRdsPgDatabasePrivilege_basic(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "sbercloud_rds_pg_account" "reader" {
+  instance_id = sbercloud_rds_instance.test.id
+  name        = "%[2]s_reader"
+  password    = "Test@12345678"
+}
+
+resource "sbercloud_rds_pg_database_privilege" "test" {
+  instance_id = sbercloud_rds_instance.test.id
+  db_name     = sbercloud_rds_pg_database.test.name
+
+  users {
+    name        = sbercloud_rds_pg_account.test.name
+    schema_name = "public"
+  }
+
+  users {
+    name        = sbercloud_rds_pg_account.reader.name
+    schema_name = "public"
+    readonly    = true
+  }
+}
+`, testRdsPgDatabase_basic(rName, ""), rName)
+}
@@ -0,0 +1,89 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	model "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+	rds2 "github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/rds"
+)
+
+func getRdsPgDatabaseFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcRdsV3Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and database from resource id
+	parts := strings.SplitN(state.Primary.ID, "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid id format, must be <instance_id>/<database_name>")
+	}
+	return rds2.QueryPgDatabase(client, parts[0], parts[1])
+}
+
+func TestAccRdsPgDatabase_basic(t *testing.T) {
+	var database model.PostgresqlListDatabase
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_rds_pg_database.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&database,
+		getRdsPgDatabaseFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testRdsPgDatabase_basic(rName, "created by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "sbercloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "owner", "sbercloud_rds_pg_account.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "character_set", "UTF8"),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+				),
+			},
+			{
+				Config: testRdsPgDatabase_basic(rName, "updated by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by acc test"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template", "lc_ctype", "is_revoke_public_privilege"},
+			},
+		},
+	})
+}
+
+func testRdsPgDatabase_basic(rName, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_rds_pg_database" "test" {
+  instance_id   = sbercloud_rds_instance.test.id
+  name          = "%s"
+  owner         = sbercloud_rds_pg_account.test.name
+  character_set = "UTF8"
+  template      = "template0"
+  description   = "%s"
+}
+`, testRdsPgAccount_basic(rName, "Test@12345678", ""), rName, description)
+}
@@ -0,0 +1,112 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	model "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccRdsSQLAudit_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_rds_sql_audit.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckRdsSQLAuditDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testRdsSQLAudit_basic(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsSQLAuditExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"sbercloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "keep_days", "5"),
+				),
+			},
+			{
+				Config: testRdsSQLAudit_basic(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsSQLAuditExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "keep_days", "10"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reserve_auditlogs"},
+			},
+		},
+	})
+}
+
+func testAccCheckRdsSQLAuditDestroy(s *terraform.State) error {
+	c := acceptance.TestAccProvider.Meta().(*config.Config)
+	client, err := c.HcRdsV3Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_rds_sql_audit" {
+			continue
+		}
+
+		resp, err := client.ShowAuditlogPolicy(&model.ShowAuditlogPolicyRequest{InstanceId: rs.Primary.ID})
+		if err != nil {
+			return nil
+		}
+		if resp.KeepDays != nil && *resp.KeepDays != 0 {
+			return fmt.Errorf("the SQL audit of RDS instance (%s) is still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckRdsSQLAuditExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		c := acceptance.TestAccProvider.Meta().(*config.Config)
+		client, err := c.HcRdsV3Client(acceptance.SBC_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating RDS client: %s", err)
+		}
+
+		resp, err := client.ShowAuditlogPolicy(&model.ShowAuditlogPolicyRequest{InstanceId: rs.Primary.ID})
+		if err != nil {
+			return err
+		}
+		if resp.KeepDays == nil || *resp.KeepDays == 0 {
+			return fmt.Errorf("the SQL audit of RDS instance (%s) is disabled", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testRdsSQLAudit_basic(rName string, keepDays int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_rds_sql_audit" "test" {
+  instance_id = sbercloud_rds_instance.test.id
+  keep_days   = %d
+}
+`, testRdsAccount_base(rName), keepDays)
+}
@@ -0,0 +1,68 @@
+package standin
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Request is the recorded part of an API request, only the fields used to match
+// the requests in replay mode are kept, the headers and the body are left out so
+// that no credentials end up in the recordings.
+type Request struct {
+	Method     string `json:"method"`
+	Host       string `json:"host"`
+	Path       string `json:"path"`
+	Query      string `json:"query,omitempty"`
+	BodySHA256 string `json:"body_sha256,omitempty"`
+}
+
+// Response is the recorded API response.
+type Response struct {
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Body       string              `json:"body,omitempty"`
+}
+
+// Interaction is a request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Cassette is the list of the interactions of a test, in the order they were recorded.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// LoadCassette reads the cassette from the file, os.ErrNotExist is returned if
+// nothing has been recorded yet.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("error parsing the recording %s: %s", path, err)
+	}
+	return &c, nil
+}
+
+// Save writes the cassette to the file, the parent directories are created if needed.
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling the recording: %s", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("error creating the directory of the recording %s: %s", path, err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("error writing the recording %s: %s", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package standin
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// testRecordingPatterns match the recordings committed for the tests of the acceptance packages and the
+// service packages which use the acceptance pre-checks, they are relative to the acceptance directory.
+var testRecordingPatterns = []string{
+	"*/testdata/recordings/*.json",
+	"../*/testdata/recordings/*.json",
+}
+
+func testRecordings(t *testing.T) []string {
+	var paths []string
+	for _, pattern := range testRecordingPatterns {
+		matches, err := filepath.Glob(filepath.Join("..", pattern))
+		if err != nil {
+			t.Fatalf("error listing the recordings: %s", err)
+		}
+		paths = append(paths, matches...)
+	}
+	return paths
+}
+
+// TestRecordings replays the interactions of the committed recordings in order, so that a recording
+// which can't be loaded or served by the stand-in is detected without running the acceptance tests.
+func TestRecordings(t *testing.T) {
+	s := testServer(t, ModeReplay, nil)
+	client := testClient(s)
+
+	for _, path := range testRecordings(t) {
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			t.Errorf("[%s] error loading the recording: %s", path, err)
+			continue
+		}
+		if len(cassette.Interactions) == 0 {
+			t.Errorf("[%s] expected the recording to have interactions", path)
+			continue
+		}
+
+		s.Use(cassette)
+		for i, interaction := range cassette.Interactions {
+			rawURL := "https://" + interaction.Request.Host + interaction.Request.Path
+			if interaction.Request.Query != "" {
+				rawURL += "?" + interaction.Request.Query
+			}
+			statusCode, body := testRequest(t, client, interaction.Request.Method, rawURL, "")
+			if statusCode != interaction.Response.StatusCode || body != interaction.Response.Body {
+				t.Errorf("[%s#%d] expected %s to be replayed with %d, got %d %s", path, i,
+					interaction.Request.String(), interaction.Response.StatusCode, statusCode, body)
+			}
+		}
+		if _, misses := s.Eject(); len(misses) != 0 {
+			t.Errorf("[%s] expected all the requests to be matched, got %v", path, misses)
+		}
+	}
+}
@@ -0,0 +1,490 @@
+// Package standin provides a local stand-in of the SberCloud APIs for the acceptance tests.
+//
+// The stand-in is an HTTPS proxy which intercepts the requests sent to the cloud domain.
+// In record mode they are forwarded to the real APIs and the interactions are stored in
+// a cassette, in replay mode they are answered from the cassette without any network
+// access. The requests to the other hosts are tunneled as they are.
+package standin
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Mode is the working mode of the stand-in.
+type Mode string
+
+const (
+	// ModeRecord forwards the requests to the real APIs and records the interactions.
+	ModeRecord Mode = "record"
+	// ModeReplay answers the requests with the recorded interactions.
+	ModeReplay Mode = "replay"
+)
+
+// hopHeaders are the hop-by-hop headers which are not forwarded, the body related
+// headers are also dropped as they are computed again when writing the response.
+var hopHeaders = []string{
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Proxy-Connection",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+	"Accept-Encoding",
+	"Content-Encoding",
+	"Content-Length",
+}
+
+// Server is the local stand-in of the SberCloud APIs.
+type Server struct {
+	mode     Mode
+	domain   string
+	listener net.Listener
+	server   *http.Server
+	tlsConf  *tls.Config
+
+	// upstream is the client used to forward the requests in record mode
+	upstream *http.Client
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+	misses   []string
+	// phase is the index of the latest mutating interaction replayed
+	phase int
+}
+
+// NewServer starts a stand-in on the loopback interface which intercepts the requests
+// sent to the hosts of the domain, e.g. hc.sbercloud.ru.
+func NewServer(mode Mode, domain string) (*Server, error) {
+	if mode != ModeRecord && mode != ModeReplay {
+		return nil, fmt.Errorf("invalid mode %q, expected %q or %q", mode, ModeRecord, ModeReplay)
+	}
+
+	cert, err := newCertificate(domain)
+	if err != nil {
+		return nil, fmt.Errorf("error generating the certificate of the stand-in: %s", err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("error starting the stand-in: %s", err)
+	}
+
+	s := &Server{
+		mode:     mode,
+		domain:   domain,
+		listener: listener,
+		tlsConf:  &tls.Config{Certificates: []tls.Certificate{cert}},
+		upstream: &http.Client{
+			// the proxy settings of the environment point to the stand-in itself
+			Transport: &http.Transport{Proxy: nil},
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+	s.server = &http.Server{Handler: http.HandlerFunc(s.handleProxy)}
+
+	go s.server.Serve(listener)
+	return s, nil
+}
+
+// URL returns the proxy URL of the stand-in.
+func (s *Server) URL() string {
+	return "http://" + s.listener.Addr().String()
+}
+
+// Close stops the stand-in.
+func (s *Server) Close() error {
+	return s.server.Close()
+}
+
+// Use starts a new session with the cassette. In record mode the interactions are
+// appended to it, in replay mode the requests are answered with its interactions.
+func (s *Server) Use(c *Cassette) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cassette = c
+	s.used = make([]bool, len(c.Interactions))
+	s.misses = nil
+	s.phase = -1
+}
+
+// Eject ends the current session and returns its cassette, along with the requests
+// which did not match any recorded interaction in replay mode.
+func (s *Server) Eject() (*Cassette, []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, misses := s.cassette, s.misses
+	s.cassette, s.used, s.misses = nil, nil, nil
+	return c, misses
+}
+
+func (s *Server) intercepts(host string) bool {
+	return host == s.domain || strings.HasSuffix(host, "."+s.domain)
+}
+
+func (s *Server) handleProxy(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodConnect {
+		http.Error(w, "the stand-in only supports the CONNECT method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "hijacking is not supported", http.StatusInternalServerError)
+		return
+	}
+	conn, _, err := hijacker.Hijack()
+	if err != nil {
+		log.Printf("[WARN] error hijacking the connection to %s: %s", r.Host, err)
+		return
+	}
+	defer conn.Close()
+
+	host, _, err := net.SplitHostPort(r.Host)
+	if err != nil {
+		host = r.Host
+	}
+	if !s.intercepts(host) {
+		tunnel(conn, r.Host)
+		return
+	}
+
+	if _, err := io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n"); err != nil {
+		return
+	}
+	tlsConn := tls.Server(conn, s.tlsConf)
+	if err := tlsConn.Handshake(); err != nil {
+		log.Printf("[WARN] error during the TLS handshake with the client of %s: %s", host, err)
+		return
+	}
+	s.serveConn(tlsConn, host)
+}
+
+// tunnel connects the client to the target directly.
+func tunnel(conn net.Conn, target string) {
+	upstream, err := net.DialTimeout("tcp", target, 30*time.Second)
+	if err != nil {
+		io.WriteString(conn, "HTTP/1.1 502 Bad Gateway\r\n\r\n")
+		return
+	}
+	defer upstream.Close()
+
+	if _, err := io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n"); err != nil {
+		return
+	}
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(upstream, conn)
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(conn, upstream)
+		done <- struct{}{}
+	}()
+	<-done
+}
+
+// serveConn answers the requests sent over the intercepted connection one by one.
+func (s *Server) serveConn(conn net.Conn, host string) {
+	reader := bufio.NewReader(conn)
+	for {
+		req, err := http.ReadRequest(reader)
+		if err != nil {
+			return
+		}
+
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return
+		}
+
+		var resp *Response
+		if s.mode == ModeRecord {
+			resp = s.record(host, req, body)
+		} else {
+			resp = s.replay(host, req, body)
+		}
+
+		header := http.Header{}
+		for k, v := range resp.Headers {
+			header[k] = v
+		}
+		httpResp := &http.Response{
+			StatusCode:    resp.StatusCode,
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(resp.Body)),
+			ContentLength: int64(len(resp.Body)),
+			Request:       req,
+		}
+		if err := httpResp.Write(conn); err != nil || req.Close {
+			return
+		}
+	}
+}
+
+func newRequest(host string, req *http.Request, body []byte) Request {
+	r := Request{
+		Method: req.Method,
+		Host:   host,
+		Path:   req.URL.Path,
+	}
+	// the query parameters are sorted by key
+	if query, err := url.ParseQuery(req.URL.RawQuery); err == nil {
+		r.Query = query.Encode()
+	} else {
+		r.Query = req.URL.RawQuery
+	}
+	if len(body) > 0 {
+		sum := sha256.Sum256(body)
+		r.BodySHA256 = hex.EncodeToString(sum[:])
+	}
+	return r
+}
+
+func (r Request) String() string {
+	s := fmt.Sprintf("%s https://%s%s", r.Method, r.Host, r.Path)
+	if r.Query != "" {
+		s += "?" + r.Query
+	}
+	return s
+}
+
+// queryEndpoints are the paths of the APIs which query the resources with POST requests,
+// indexed by the service name of the host.
+var queryEndpoints = map[string]*regexp.Regexp{
+	"drs": regexp.MustCompile(`^/v3/[^/]+/jobs(/batch-(detail|status|precheck-result))?$`),
+}
+
+// mutating reports whether the request may change the state of the resources.
+func (r Request) mutating() bool {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return false
+	}
+	if r.Method == http.MethodPost {
+		service := strings.SplitN(r.Host, ".", 2)[0]
+		if pattern, ok := queryEndpoints[service]; ok && pattern.MatchString(r.Path) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r Request) sameEndpoint(o Request) bool {
+	return r.Method == o.Method && r.Host == o.Host && r.Path == o.Path && r.Query == o.Query
+}
+
+func (s *Server) record(host string, req *http.Request, body []byte) *Response {
+	outReq, err := http.NewRequest(req.Method, "https://"+host+req.URL.RequestURI(), bytes.NewReader(body))
+	if err != nil {
+		return errorResponse(http.StatusBadGateway, err.Error())
+	}
+	outReq.Header = req.Header.Clone()
+	for _, h := range hopHeaders {
+		outReq.Header.Del(h)
+	}
+	outReq.Host = req.Host
+
+	outResp, err := s.upstream.Do(outReq)
+	if err != nil {
+		return errorResponse(http.StatusBadGateway, err.Error())
+	}
+	defer outResp.Body.Close()
+
+	respBody, err := io.ReadAll(outResp.Body)
+	if err != nil {
+		return errorResponse(http.StatusBadGateway, err.Error())
+	}
+
+	header := outResp.Header.Clone()
+	for _, h := range hopHeaders {
+		header.Del(h)
+	}
+	header.Del("Set-Cookie")
+	resp := &Response{
+		StatusCode: outResp.StatusCode,
+		Headers:    header,
+		Body:       string(respBody),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cassette == nil {
+		log.Printf("[WARN] the stand-in has no recording in progress, %s %s is not recorded", req.Method, host)
+		return resp
+	}
+	s.cassette.Interactions = append(s.cassette.Interactions, &Interaction{
+		Request:  newRequest(host, req, body),
+		Response: *resp,
+	})
+	return resp
+}
+
+// replay answers the request with a recorded interaction of the same endpoint. The
+// interactions are split into phases by the mutating requests, e.g. POST and DELETE,
+// so that the number of the status polling requests of Terraform does not need to
+// match the recording. The POST requests of the query APIs, e.g. the details of the
+// DRS jobs, are reading ones:
+//   - a mutating request is answered with the first unused interaction, the one with
+//     the same body is preferred as the resources may be created concurrently.
+//   - a reading request is answered with the first unused interaction of the current
+//     phase, the last one is repeated once all of them are used, and the interactions
+//     of the previous phases are used if there is none in the current phase.
+func (s *Server) replay(host string, req *http.Request, body []byte) *Response {
+	r := newRequest(host, req, body)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cassette == nil {
+		return errorResponse(http.StatusNotFound, "the stand-in has no recording in use")
+	}
+
+	var index int
+	if r.mutating() {
+		index = s.replayMutating(r)
+	} else {
+		index = s.replayReading(r)
+	}
+	if index == -1 {
+		s.misses = append(s.misses, r.String())
+		return errorResponse(http.StatusNotFound, "no recorded interaction for "+r.String())
+	}
+
+	s.used[index] = true
+	if r.mutating() && index > s.phase {
+		s.phase = index
+	}
+	resp := s.cassette.Interactions[index].Response
+	return &resp
+}
+
+func (s *Server) replayMutating(r Request) int {
+	unused, latest, last := -1, -1, -1
+	for i, interaction := range s.cassette.Interactions {
+		if !interaction.Request.sameEndpoint(r) {
+			continue
+		}
+		last = i
+		if i <= s.phase {
+			latest = i
+		}
+		if s.used[i] {
+			continue
+		}
+		if interaction.Request.BodySHA256 == r.BodySHA256 {
+			return i
+		}
+		if unused == -1 {
+			unused = i
+		}
+	}
+
+	switch {
+	case unused != -1:
+		return unused
+	case latest != -1:
+		return latest
+	default:
+		return last
+	}
+}
+
+func (s *Server) replayReading(r Request) int {
+	// the current phase ends with the next mutating interaction which is not used yet
+	end := len(s.cassette.Interactions)
+	for i := s.phase + 1; i < end; i++ {
+		if s.cassette.Interactions[i].Request.mutating() && !s.used[i] {
+			end = i
+			break
+		}
+	}
+
+	current, previous, next := -1, -1, -1
+	for i, interaction := range s.cassette.Interactions {
+		if !interaction.Request.sameEndpoint(r) {
+			continue
+		}
+		switch {
+		case i <= s.phase:
+			previous = i
+		case i < end:
+			if !s.used[i] {
+				return i
+			}
+			current = i
+		case next == -1:
+			next = i
+		}
+	}
+
+	switch {
+	case current != -1:
+		return current
+	case previous != -1:
+		return previous
+	default:
+		return next
+	}
+}
+
+func errorResponse(statusCode int, msg string) *Response {
+	return &Response{
+		StatusCode: statusCode,
+		Headers:    map[string][]string{"Content-Type": {"application/json"}},
+		Body:       fmt.Sprintf(`{"error_code":"STANDIN.%d","error_msg":%q}`, statusCode, msg),
+	}
+}
+
+// newCertificate generates the self-signed certificate presented to the clients, they
+// must skip the verification of the server certificate.
+func newCertificate(domain string) (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: domain},
+		DNSNames:     []string{domain, "*." + domain},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
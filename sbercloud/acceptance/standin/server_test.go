@@ -0,0 +1,259 @@
+package standin
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+const testDomain = "hc.sbercloud.ru"
+
+// testUpstream starts the fake cloud API, every response contains a sequence number
+// so that the replayed responses can be told apart.
+func testUpstream(t *testing.T) *httptest.Server {
+	var seq int32
+	upstream := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		n := atomic.AddInt32(&seq, 1)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		if r.URL.Path == "/v1/project-id/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		fmt.Fprintf(w, `{"seq":%d,"host":%q,"query":%q,"body":%q}`, n, r.Host, r.URL.RawQuery, body)
+	}))
+	t.Cleanup(upstream.Close)
+	return upstream
+}
+
+func testServer(t *testing.T, mode Mode, upstream *httptest.Server) *Server {
+	s, err := NewServer(mode, testDomain)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	if upstream != nil {
+		// all the hosts of the cloud domain are served by the fake upstream
+		addr := upstream.Listener.Addr().String()
+		s.upstream.Transport = &http.Transport{
+			DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, addr)
+			},
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
+	return s
+}
+
+func testClient(s *Server) *http.Client {
+	proxyURL, _ := url.Parse(s.URL())
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyURL(proxyURL),
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+}
+
+func testRequest(t *testing.T, client *http.Client, method, rawURL, body string) (int, string) {
+	req, err := http.NewRequest(method, rawURL, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("error requesting %s: %s", rawURL, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if resp.Header.Get("Set-Cookie") != "" {
+		t.Errorf("expected the cookies not to be returned by the stand-in")
+	}
+	return resp.StatusCode, string(respBody)
+}
+
+func TestServer_recordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recordings", "TestServer.json")
+	ecsURL := "https://ecs.ru-moscow-1." + testDomain + "/v1/project-id"
+
+	// record the interactions with the fake upstream
+	recorder := testServer(t, ModeRecord, testUpstream(t))
+	recorder.Use(&Cassette{})
+	client := testClient(recorder)
+
+	recorded := []struct {
+		method, url, body string
+	}{
+		{"GET", ecsURL + "/servers?limit=10&offset=0", ""},
+		{"GET", ecsURL + "/servers?limit=10&offset=0", ""},
+		{"POST", ecsURL + "/servers", `{"name":"vm-1"}`},
+		{"POST", ecsURL + "/servers", `{"name":"vm-2"}`},
+		{"GET", ecsURL + "/missing", ""},
+	}
+	expected := make([]string, len(recorded))
+	for i, r := range recorded {
+		_, expected[i] = testRequest(t, client, r.method, r.url, r.body)
+		if !strings.Contains(expected[i], `"host":"ecs.ru-moscow-1.`+testDomain+`"`) {
+			t.Fatalf("expected the request to be forwarded to the upstream host, got %s", expected[i])
+		}
+	}
+
+	cassette, _ := recorder.Eject()
+	if len(cassette.Interactions) != len(recorded) {
+		t.Fatalf("expected %d interactions to be recorded, got %d", len(recorded), len(cassette.Interactions))
+	}
+	if err := cassette.Save(path); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// replay them without the upstream
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	replayer := testServer(t, ModeReplay, nil)
+	replayer.Use(cassette)
+	client = testClient(replayer)
+
+	cases := []struct {
+		method, url, body string
+		statusCode        int
+		expected          string
+	}{
+		// the order of the query parameters does not matter
+		{"GET", ecsURL + "/servers?offset=0&limit=10", "", http.StatusOK, expected[0]},
+		{"GET", ecsURL + "/servers?limit=10&offset=0", "", http.StatusOK, expected[1]},
+		// the last response is repeated once all of them are used
+		{"GET", ecsURL + "/servers?limit=10&offset=0", "", http.StatusOK, expected[1]},
+		// the requests with the same body are matched first
+		{"POST", ecsURL + "/servers", `{"name":"vm-2"}`, http.StatusOK, expected[3]},
+		{"POST", ecsURL + "/servers", `{"name":"vm-1"}`, http.StatusOK, expected[2]},
+		{"GET", ecsURL + "/missing", "", http.StatusNotFound, expected[4]},
+	}
+	for _, tc := range cases {
+		statusCode, body := testRequest(t, client, tc.method, tc.url, tc.body)
+		if statusCode != tc.statusCode || body != tc.expected {
+			t.Errorf("[%s %s] expected %d %s, got %d %s", tc.method, tc.url, tc.statusCode, tc.expected,
+				statusCode, body)
+		}
+	}
+
+	statusCode, _ := testRequest(t, client, "DELETE", ecsURL+"/servers/vm-1", "")
+	if statusCode != http.StatusNotFound {
+		t.Errorf("expected the request which was not recorded to be rejected, got %d", statusCode)
+	}
+	if _, misses := replayer.Eject(); len(misses) != 1 ||
+		misses[0] != "DELETE https://ecs.ru-moscow-1."+testDomain+"/v1/project-id/servers/vm-1" {
+		t.Errorf("expected the request which was not recorded to be reported, got %v", misses)
+	}
+}
+
+func TestServer_replayPhases(t *testing.T) {
+	host := "ecs.ru-moscow-1." + testDomain
+	serverURL := "https://" + host + "/v1/project-id/servers/vm-1"
+	interaction := func(method, status string, statusCode int) *Interaction {
+		return &Interaction{
+			Request:  Request{Method: method, Host: host, Path: "/v1/project-id/servers/vm-1"},
+			Response: Response{StatusCode: statusCode, Body: status},
+		}
+	}
+	cassette := func() *Cassette {
+		return &Cassette{Interactions: []*Interaction{
+			interaction("GET", "BUILD", http.StatusOK),
+			interaction("GET", "ACTIVE", http.StatusOK),
+			interaction("DELETE", "", http.StatusNoContent),
+			interaction("GET", "DELETING", http.StatusOK),
+			interaction("GET", "", http.StatusNotFound),
+		}}
+	}
+
+	cases := map[string][]struct {
+		method     string
+		statusCode int
+		expected   string
+	}{
+		"more polling": {
+			{"GET", http.StatusOK, "BUILD"},
+			{"GET", http.StatusOK, "ACTIVE"},
+			{"GET", http.StatusOK, "ACTIVE"},
+			{"DELETE", http.StatusNoContent, ""},
+			{"GET", http.StatusOK, "DELETING"},
+			{"GET", http.StatusNotFound, ""},
+			{"GET", http.StatusNotFound, ""},
+		},
+		"less polling": {
+			{"GET", http.StatusOK, "BUILD"},
+			{"DELETE", http.StatusNoContent, ""},
+			{"GET", http.StatusOK, "DELETING"},
+		},
+	}
+
+	s := testServer(t, ModeReplay, nil)
+	client := testClient(s)
+	for name, requests := range cases {
+		s.Use(cassette())
+		for i, r := range requests {
+			statusCode, body := testRequest(t, client, r.method, serverURL, "")
+			if statusCode != r.statusCode || body != r.expected {
+				t.Errorf("[%s#%d] expected %d %q, got %d %q", name, i, r.statusCode, r.expected, statusCode, body)
+			}
+		}
+		if _, misses := s.Eject(); len(misses) != 0 {
+			t.Errorf("[%s] expected all the requests to be matched, got %v", name, misses)
+		}
+	}
+}
+
+func TestRequest_mutating(t *testing.T) {
+	cases := []struct {
+		request  Request
+		expected bool
+	}{
+		{Request{Method: "GET", Host: "ecs.ru-moscow-1." + testDomain, Path: "/v1/p/servers/vm-1"}, false},
+		{Request{Method: "POST", Host: "ecs.ru-moscow-1." + testDomain, Path: "/v1/p/servers"}, true},
+		{Request{Method: "DELETE", Host: "drs.ru-moscow-1." + testDomain, Path: "/v3/p/jobs"}, true},
+		{Request{Method: "POST", Host: "drs.ru-moscow-1." + testDomain, Path: "/v3/p/jobs"}, false},
+		{Request{Method: "POST", Host: "drs.ru-moscow-1." + testDomain, Path: "/v3/p/jobs/batch-detail"}, false},
+		{Request{Method: "POST", Host: "drs.ru-moscow-1." + testDomain, Path: "/v3/p/jobs/batch-creation"}, true},
+	}
+
+	for _, c := range cases {
+		if actual := c.request.mutating(); actual != c.expected {
+			t.Errorf("[%s] expected mutating to be %t, got %t", c.request.String(), c.expected, actual)
+		}
+	}
+}
+
+func TestServer_tunnel(t *testing.T) {
+	other := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "direct")
+	}))
+	defer other.Close()
+
+	s := testServer(t, ModeReplay, nil)
+	_, body := testRequest(t, testClient(s), "GET", other.URL, "")
+	if body != "direct" {
+		t.Errorf("expected the requests to the other hosts to be tunneled, got %s", body)
+	}
+}
+
+func TestNewServer_invalidMode(t *testing.T) {
+	if _, err := NewServer("live", testDomain); err == nil {
+		t.Error("expected an error with an invalid mode")
+	}
+}
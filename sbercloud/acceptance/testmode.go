@@ -0,0 +1,224 @@
+package acceptance
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance/standin"
+)
+
+const (
+	// cloudDomain is the domain of the SberCloud APIs, the requests sent to its hosts
+	// are served by the stand-in.
+	cloudDomain = "hc.sbercloud.ru"
+
+	replayRegion    = "ru-moscow-1"
+	replayAccessKey = "replay-access-key"
+	replaySecretKey = "replay-secret-key"
+
+	randCharSet = "abcdefghijklmnopqrstuvwxyz"
+)
+
+// SBC_TEST_MODE selects how the acceptance tests reach the APIs:
+//   - unset: the tests call the real APIs.
+//   - record: the tests call the real APIs through the stand-in, which saves the
+//     interactions to testdata/recordings/<TestName>.json in the package of the test.
+//   - replay: the tests are served by the stand-in with the recorded interactions,
+//     no credentials nor network access are needed.
+var SBC_TEST_MODE = os.Getenv("SBC_TEST_MODE")
+
+var (
+	testStandin *standin.Server
+
+	// testSlot makes the tests use the stand-in one at a time, as the requests
+	// can't be attributed to a test
+	testSlot    = make(chan struct{}, 1)
+	testSession struct {
+		sync.Mutex
+		name string
+	}
+
+	randCounters = map[string]int{}
+	randLock     sync.Mutex
+)
+
+// initTestMode starts the stand-in and redirects the provider to it when SBC_TEST_MODE is set.
+func initTestMode() {
+	if SBC_TEST_MODE == "" {
+		return
+	}
+
+	server, err := standin.NewServer(standin.Mode(SBC_TEST_MODE), cloudDomain)
+	if err != nil {
+		panic(fmt.Sprintf("error starting the stand-in of SBC_TEST_MODE: %s", err))
+	}
+	testStandin = server
+
+	// all the HTTP clients of the provider honor the proxy settings of the environment
+	os.Setenv("HTTPS_PROXY", server.URL())
+	os.Setenv("NO_PROXY", "")
+	os.Setenv("no_proxy", "")
+
+	replay := standin.Mode(SBC_TEST_MODE) == standin.ModeReplay
+	if replay {
+		if SBC_REGION_NAME == "" {
+			SBC_REGION_NAME = replayRegion
+		}
+		if SBC_ACCESS_KEY == "" || SBC_SECRET_KEY == "" {
+			SBC_ACCESS_KEY, SBC_SECRET_KEY = replayAccessKey, replaySecretKey
+		}
+	}
+
+	configure := TestAccProvider.ConfigureFunc
+	TestAccProvider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		// the certificate of the stand-in is self-signed
+		if err := d.Set("insecure", true); err != nil {
+			return nil, err
+		}
+
+		if replay {
+			// the requests never reach the cloud, so the real credentials are not needed
+			d.Set("access_key", replayAccessKey)
+			d.Set("secret_key", replaySecretKey)
+			d.Set("security_token", "")
+			if d.Get("region").(string) == "" {
+				d.Set("region", SBC_REGION_NAME)
+			}
+		}
+		return configure(d)
+	}
+}
+
+func recordingPath(t *testing.T) string {
+	return filepath.Join("testdata", "recordings", strings.ReplaceAll(t.Name(), "/", "_")+".json")
+}
+
+// preCheckTestMode hands the stand-in over to the test, the test fails in replay mode
+// if nothing has been recorded for it.
+func preCheckTestMode(t *testing.T) {
+	if testStandin == nil {
+		return
+	}
+
+	testSession.Lock()
+	inUse := testSession.name == t.Name()
+	testSession.Unlock()
+	if inUse {
+		return
+	}
+
+	testSlot <- struct{}{}
+	testSession.Lock()
+	testSession.name = t.Name()
+	testSession.Unlock()
+
+	path := recordingPath(t)
+	record := standin.Mode(SBC_TEST_MODE) == standin.ModeRecord
+	t.Cleanup(func() {
+		cassette, misses := testStandin.Eject()
+		for _, m := range misses {
+			t.Logf("[WARN] no recorded interaction for %s, the test may need to be recorded again", m)
+		}
+		// the recording is kept only if the test passed
+		if record && cassette != nil && !t.Failed() && !t.Skipped() {
+			if err := cassette.Save(path); err != nil {
+				t.Errorf("error saving the recording: %s", err)
+			}
+		}
+
+		testSession.Lock()
+		testSession.name = ""
+		testSession.Unlock()
+		<-testSlot
+	})
+
+	if record {
+		testStandin.Use(&standin.Cassette{})
+		return
+	}
+
+	cassette, err := standin.LoadCassette(path)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("no recording found in %s, run the test with SBC_TEST_MODE=record first", path)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStandin.Use(cassette)
+}
+
+// testFuncName returns the name of the test function, e.g. ecs.TestAccComputeV2Instance_basic,
+// when the function is the test itself or one of its closures.
+func testFuncName(function string) string {
+	parts := strings.Split(function[strings.LastIndex(function, "/")+1:], ".")
+	for i := 1; i < len(parts); i++ {
+		if strings.HasPrefix(parts[i], "Test") {
+			return strings.Join(parts[:i+1], ".")
+		}
+	}
+	return ""
+}
+
+// randSeed returns the seed of the random values in record and replay modes. It is derived
+// from the calling test and the number of values it generated, so that the requests of
+// the test are the same each time it is run.
+func randSeed() [sha256.Size]byte {
+	name := "unknown"
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if n := testFuncName(frame.Function); n != "" {
+			name = n
+			break
+		}
+		if !more {
+			break
+		}
+	}
+
+	randLock.Lock()
+	randCounters[name]++
+	count := randCounters[name]
+	randLock.Unlock()
+
+	return sha256.Sum256([]byte(fmt.Sprintf("%s#%d", name, count)))
+}
+
+func randString(n int) string {
+	return randStringFromCharSet(n, randCharSet)
+}
+
+func randStringFromCharSet(n int, charSet string) string {
+	if SBC_TEST_MODE == "" {
+		return acctest.RandStringFromCharSet(n, charSet)
+	}
+
+	seed := randSeed()
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = charSet[int(seed[i%len(seed)])%len(charSet)]
+	}
+	return string(b)
+}
+
+// randIntRange returns a random integer in [min, max).
+func randIntRange(min, max int) int {
+	if SBC_TEST_MODE == "" {
+		return acctest.RandIntRange(min, max)
+	}
+
+	seed := randSeed()
+	return min + int(binary.BigEndian.Uint32(seed[:4])%uint32(max-min))
+}
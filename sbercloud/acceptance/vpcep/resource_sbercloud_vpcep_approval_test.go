@@ -0,0 +1,114 @@
+package vpcep
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/chnsz/golangsdk/openstack/vpcep/v1/endpoints"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccVPCEndpointApproval_Basic(t *testing.T) {
+	var endpoint endpoints.Endpoint
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_vpcep_approval.approval"
+
+	rc := acceptance.InitResourceCheck(
+		"sbercloud_vpcep_endpoint.test",
+		&endpoint,
+		getVpcepEndpointResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointApproval_Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "id", "sbercloud_vpcep_service.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "connections.0.endpoint_id",
+						"sbercloud_vpcep_endpoint.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "connections.0.status", "accepted"),
+				),
+			},
+			{
+				Config: testAccVPCEndpointApproval_Update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "connections.0.endpoint_id",
+						"sbercloud_vpcep_endpoint.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "connections.0.status", "rejected"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVPCEndpointApproval_Base(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_vpcep_service" "test" {
+  name        = "%s"
+  server_type = "VM"
+  vpc_id      = sbercloud_vpc.test.id
+  port_id     = sbercloud_compute_instance.ecs.network[0].port
+  approval    = true
+
+  port_mapping {
+    service_port  = 8080
+    terminal_port = 80
+  }
+  tags = {
+    owner = "tf-acc"
+  }
+}
+
+resource "sbercloud_vpcep_endpoint" "test" {
+  service_id  = sbercloud_vpcep_service.test.id
+  vpc_id      = sbercloud_vpc.test.id
+  network_id  = sbercloud_vpc_subnet.test.id
+  enable_dns  = true
+
+  tags = {
+    owner = "tf-acc"
+  }
+  lifecycle {
+    ignore_changes = [enable_dns]
+  }
+}
+`, testAccVPCEndpoint_Precondition(rName), rName)
+}
+
+func testAccVPCEndpointApproval_Basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_vpcep_approval" "approval" {
+  service_id = sbercloud_vpcep_service.test.id
+  endpoints  = [sbercloud_vpcep_endpoint.test.id]
+}
+`, testAccVPCEndpointApproval_Base(rName))
+}
+
+func testAccVPCEndpointApproval_Update(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_vpcep_approval" "approval" {
+  service_id = sbercloud_vpcep_service.test.id
+  endpoints  = []
+}
+`, testAccVPCEndpointApproval_Base(rName))
+}
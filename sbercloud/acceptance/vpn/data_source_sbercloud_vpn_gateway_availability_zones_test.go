@@ -0,0 +1,35 @@
+package vpn
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccDataSourceVpnGatewayAZs_basic(t *testing.T) {
+	rName := "data.sbercloud_vpn_gateway_availability_zones.test"
+	dc := acceptance.InitDataSourceCheck(rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceVpnGatewayAZs_basic,
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(rName, "names.0"),
+					resource.TestCheckResourceAttr(rName, "attachment_type", "vpc"),
+				),
+			},
+		},
+	})
+}
+
+const testDataSourceVpnGatewayAZs_basic = `
+data "sbercloud_vpn_gateway_availability_zones" "test" {
+  flavor = "Professional1"
+}
+`
@@ -0,0 +1,34 @@
+package vpn
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccDataSourceVpnGatewayFlavors_basic(t *testing.T) {
+	rName := "data.sbercloud_vpn_gateway_flavors.test"
+	dc := acceptance.InitDataSourceCheck(rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceVpnGatewayFlavors_basic,
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(rName, "names.0"),
+					resource.TestCheckResourceAttrSet(rName, "flavors.0.name"),
+					resource.TestCheckResourceAttrSet(rName, "flavors.0.availability_zones.0"),
+				),
+			},
+		},
+	})
+}
+
+const testDataSourceVpnGatewayFlavors_basic = `
+data "sbercloud_vpn_gateway_flavors" "test" {}
+`
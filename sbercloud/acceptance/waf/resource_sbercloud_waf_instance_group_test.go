@@ -0,0 +1,96 @@
+package waf
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/waf_hw/v1/pools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getWafInstanceGroupFunc(c *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := c.WafDedicatedV1Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud WAF dedicated client: %s", err)
+	}
+	return pools.Get(client, state.Primary.ID)
+}
+
+func TestAccWafInstanceGroup_basic(t *testing.T) {
+	var group pools.Pool
+	resourceName := "sbercloud_waf_instance_group.group_1"
+	name := acceptance.RandomAccResourceName()
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&group,
+		getWafInstanceGroupFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafInstanceGroup_conf(name, name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			{
+				Config: testAccWafInstanceGroup_conf(name, name+"_updated"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name+"_updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccWafInstanceGroup_conf(baseName, groupName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "sbercloud_availability_zones" "test" {}
+
+data "sbercloud_compute_flavors" "flavors" {
+  availability_zone = data.sbercloud_availability_zones.zones.names[1]
+  performance_type  = "normal"
+  cpu_core_count    = 2
+}
+
+resource "sbercloud_waf_dedicated_instance" "instance_1" {
+  name               = "%s"
+  available_zone     = data.sbercloud_availability_zones.test.names[1]
+  specification_code = "waf.instance.professional"
+  ecs_flavor         = data.sbercloud_compute_flavors.flavors.ids[0]
+  vpc_id             = sbercloud_vpc.test.id
+  subnet_id          = sbercloud_vpc_subnet.test.id
+  
+  security_group = [
+    sbercloud_networking_secgroup.test.id
+  ]
+}
+
+resource "sbercloud_waf_instance_group" "group_1" {
+  name   = "%s"
+  vpc_id = sbercloud_vpc.test.id
+
+  depends_on = [sbercloud_waf_dedicated_instance.instance_1]
+}
+`, acceptance.TestBaseNetwork(baseName), baseName, groupName)
+}
@@ -0,0 +1,94 @@
+package aom
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getPromInstanceResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("aom", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating AOM client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v1/{project_id}/aom/prometheus?prom_id=" + state.Primary.ID
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		MoreHeaders:      map[string]string{"Enterprise-Project-Id": "all_granted_eps"},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	instance := utils.PathSearch(fmt.Sprintf("prometheus[?prom_id=='%s']|[0]", state.Primary.ID), respBody, nil)
+	if instance == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	if deletedTime, _ := utils.PathSearch("deleted_time", instance, nil).(float64); deletedTime != 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return instance, nil
+}
+
+func TestAccAOMPromInstance_basic(t *testing.T) {
+	var obj interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_aom_prom_instance.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getPromInstanceResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAOMPromInstance_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "prom_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "prom_type", "REMOTE_WRITE"),
+					resource.TestCheckResourceAttrSet(resourceName, "remote_write_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "prom_http_api_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAOMPromInstance_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_aom_prom_instance" "test" {
+  prom_name = "%s"
+  prom_type = "REMOTE_WRITE"
+}
+`, rName)
+}
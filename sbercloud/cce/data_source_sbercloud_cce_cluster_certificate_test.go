@@ -0,0 +1,56 @@
+package cce
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccCCEClusterCertificateDataSource_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	dataSourceName := "data.sbercloud_cce_cluster_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCEClusterCertificateDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "sbercloud_cce_cluster.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "duration", "7"),
+					resource.TestMatchResourceAttr(dataSourceName, "internal_endpoint", regexp.MustCompile(`^https://`)),
+					resource.TestMatchResourceAttr(dataSourceName, "external_endpoint", regexp.MustCompile(`^https://`)),
+					resource.TestMatchResourceAttr(dataSourceName, "cluster_ca_certificate",
+						regexp.MustCompile(`^-----BEGIN CERTIFICATE-----`)),
+					resource.TestMatchResourceAttr(dataSourceName, "client_certificate",
+						regexp.MustCompile(`^-----BEGIN CERTIFICATE-----`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "client_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "kube_config_raw"),
+					resource.TestCheckResourceAttrSet("data.sbercloud_cce_cluster_certificate.short_lived",
+						"client_certificate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCCEClusterCertificateDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "sbercloud_cce_cluster_certificate" "test" {
+  cluster_id = sbercloud_cce_cluster.test.id
+  duration   = 7
+}
+
+data "sbercloud_cce_cluster_certificate" "short_lived" {
+  cluster_id  = sbercloud_cce_cluster.test.id
+  short_lived = true
+}
+`, testAccCCEClusterV3_withEip(rName))
+}
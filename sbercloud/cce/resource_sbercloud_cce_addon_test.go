@@ -217,6 +217,8 @@ resource "sbercloud_cce_node_pool" "test" {
     size       = 100
     volumetype = "SAS"
   }
+
+  depends_on = [sbercloud_cce_addon.test]
 }
 
 data "sbercloud_cce_addon_template" "test" {
@@ -242,7 +244,7 @@ resource "sbercloud_cce_addon" "test" {
     flavor_json = jsonencode(jsondecode(data.sbercloud_cce_addon_template.test.spec).parameters.flavor2)
   }
 
-  depends_on = [sbercloud_cce_node_pool.test]
+  depends_on = [sbercloud_cce_node.test]
 }
-`, testAccCCENodePool_Base(rName), rName, acceptance.SBC_PROJECT_ID)
+`, testAccCCEAddonV3_Base(rName), rName, acceptance.SBC_PROJECT_ID)
 }
@@ -0,0 +1,294 @@
+package cce
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getAutoscalingPolicyResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.CceAddonV3Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CCE add-on client: %s", err)
+	}
+	endpoint, err := url.Parse(client.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	getPath := fmt.Sprintf("https://%s.%s/apis/autoscaling.cce.io/v1alpha1/namespaces/kube-system/"+
+		"horizontalnodeautoscalers/%s", state.Primary.Attributes["cluster_id"], endpoint.Host, state.Primary.ID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving CCE autoscaling policy: %s", err)
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccCCEAutoscalingPolicy_basic(t *testing.T) {
+	var obj interface{}
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_cce_autoscaling_policy.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getAutoscalingPolicyResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCEAutoscalingPolicy_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "nodepool_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.type", "Metric"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.metric_trigger.0.metric_name", "Cpu"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action.0.value", "1"),
+					resource.TestCheckResourceAttr("sbercloud_cce_node_pool.test", "scall_enable", "true"),
+				),
+			},
+			{
+				Config: testAccCCEAutoscalingPolicy_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.type", "Cron"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.cron_trigger.0.schedule", "0 22 * * *"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.action.0.unit", "Percent"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccCCEAutoscalingPolicyImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccCCEAutoscalingPolicy_withoutAutoscaler(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCCEAutoscalingPolicy_withoutAutoscaler(rName),
+				ExpectError: regexp.MustCompile("the autoscaler add-on is not installed"),
+			},
+		},
+	})
+}
+
+func testAccCCEAutoscalingPolicyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["cluster_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCCEAutoscalingPolicy_Base(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "sbercloud_cce_node_pool" "base" {
+  cluster_id         = sbercloud_cce_cluster.test.id
+  name               = "%[2]s-base"
+  os                 = "CentOS 7.6"
+  flavor_id          = "c6nl.large.2"
+  initial_node_count = 2
+  availability_zone  = data.sbercloud_availability_zones.test.names[0]
+  key_pair           = sbercloud_compute_keypair.test.name
+
+  root_volume {
+    size       = 50
+    volumetype = "SAS"
+  }
+  data_volumes {
+    size       = 100
+    volumetype = "SAS"
+  }
+}
+
+data "sbercloud_cce_addon_template" "test" {
+  cluster_id = sbercloud_cce_cluster.test.id
+  name       = "autoscaler"
+  version    = "1.23.3"
+}
+
+resource "sbercloud_cce_addon" "test" {
+  cluster_id    = sbercloud_cce_cluster.test.id
+  template_name = "autoscaler"
+  version       = "1.23.3"
+
+  values {
+    basic  = jsondecode(data.sbercloud_cce_addon_template.test.spec).basic
+    custom = merge(
+      jsondecode(data.sbercloud_cce_addon_template.test.spec).parameters.custom,
+      {
+        cluster_id = sbercloud_cce_cluster.test.id
+        tenant_id  = "%[3]s"
+      }
+    )
+    flavor_json = jsonencode(jsondecode(data.sbercloud_cce_addon_template.test.spec).parameters.flavor2)
+  }
+
+  depends_on = [sbercloud_cce_node_pool.base]
+}
+
+resource "sbercloud_cce_node_pool" "test" {
+  cluster_id               = sbercloud_cce_cluster.test.id
+  name                     = "%[2]s"
+  os                       = "CentOS 7.6"
+  flavor_id                = "c6nl.large.2"
+  initial_node_count       = 1
+  availability_zone        = data.sbercloud_availability_zones.test.names[0]
+  key_pair                 = sbercloud_compute_keypair.test.name
+  scall_enable             = true
+  min_node_count           = 1
+  max_node_count           = 3
+  scale_down_cooldown_time = 10
+  priority                 = 1
+
+  root_volume {
+    size       = 50
+    volumetype = "SAS"
+  }
+  data_volumes {
+    size       = 100
+    volumetype = "SAS"
+  }
+
+  depends_on = [sbercloud_cce_addon.test]
+}
+`, testAccCCENodePool_Base(rName), rName, acceptance.SBC_PROJECT_ID)
+}
+
+func testAccCCEAutoscalingPolicy_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_cce_autoscaling_policy" "test" {
+  cluster_id   = sbercloud_cce_cluster.test.id
+  name         = "%s"
+  nodepool_ids = [sbercloud_cce_node_pool.test.id]
+
+  rules {
+    name = "cpu-scale-up"
+    type = "Metric"
+
+    action {
+      type  = "ScaleUp"
+      value = 1
+    }
+
+    metric_trigger {
+      metric_name = "Cpu"
+      operator    = ">"
+      value       = "80"
+    }
+  }
+}
+`, testAccCCEAutoscalingPolicy_Base(rName), rName)
+}
+
+func testAccCCEAutoscalingPolicy_update(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_cce_autoscaling_policy" "test" {
+  cluster_id   = sbercloud_cce_cluster.test.id
+  name         = "%s"
+  nodepool_ids = [sbercloud_cce_node_pool.test.id]
+
+  rules {
+    name    = "cpu-scale-up"
+    type    = "Metric"
+    enabled = false
+
+    action {
+      type  = "ScaleUp"
+      value = 1
+    }
+
+    metric_trigger {
+      metric_name = "Cpu"
+      operator    = ">"
+      value       = "70"
+    }
+  }
+
+  rules {
+    name = "night-scale-down"
+    type = "Cron"
+
+    action {
+      type  = "ScaleDown"
+      unit  = "Percent"
+      value = 50
+    }
+
+    cron_trigger {
+      schedule = "0 22 * * *"
+    }
+  }
+}
+`, testAccCCEAutoscalingPolicy_Base(rName), rName)
+}
+
+func testAccCCEAutoscalingPolicy_withoutAutoscaler(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_cce_autoscaling_policy" "test" {
+  cluster_id   = sbercloud_cce_cluster.test.id
+  name         = "%s"
+  nodepool_ids = ["test"]
+
+  rules {
+    name = "night-scale-down"
+    type = "Cron"
+
+    action {
+      type  = "ScaleDown"
+      value = 1
+    }
+
+    cron_trigger {
+      schedule = "0 22 * * *"
+    }
+  }
+}
+`, testAccCCENodePool_Base(rName), rName)
+}
@@ -103,6 +103,76 @@ func TestAccCCEClusterV3_withEpsId(t *testing.T) {
 	})
 }
 
+func TestAccCCEClusterV3_hibernate(t *testing.T) {
+	var cluster clusters.Clusters
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_cce_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCCEClusterV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCEClusterV3_hibernate(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCEClusterV3Exists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "status", "Available"),
+				),
+			},
+			{
+				Config: testAccCCEClusterV3_hibernate(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCEClusterV3Exists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "hibernate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Hibernation"),
+				),
+			},
+			{
+				Config: testAccCCEClusterV3_hibernate(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCEClusterV3Exists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "hibernate", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Available"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCCEClusterV3_upgrade(t *testing.T) {
+	var cluster clusters.Clusters
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_cce_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCCEClusterV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCEClusterV3_version(rName, "v1.23"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCEClusterV3Exists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "cluster_version", "v1.23"),
+				),
+			},
+			{
+				Config: testAccCCEClusterV3_version(rName, "v1.25"),
+				Check: resource.ComposeTestCheckFunc(
+					// The cluster is upgraded in place.
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &cluster.Metadata.Id),
+					testAccCheckCCEClusterV3Exists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "cluster_version", "v1.25"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Available"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCCEClusterV3Destroy(s *terraform.State) error {
 	config := acceptance.TestAccProvider.Meta().(*config.Config)
 	cceClient, err := config.CceV3Client(acceptance.SBC_REGION_NAME)
@@ -249,3 +319,33 @@ resource "sbercloud_cce_cluster" "test" {
 
 `, testAccCCEClusterV3_Base(rName), rName, acceptance.SBC_ENTERPRISE_PROJECT_ID)
 }
+
+func testAccCCEClusterV3_hibernate(rName string, hibernate bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_cce_cluster" "test" {
+  name                   = "%s"
+  flavor_id              = "cce.s1.small"
+  vpc_id                 = sbercloud_vpc.test.id
+  subnet_id              = sbercloud_vpc_subnet.test.id
+  container_network_type = "overlay_l2"
+  hibernate              = %t
+}
+`, testAccCCEClusterV3_Base(rName), rName, hibernate)
+}
+
+func testAccCCEClusterV3_version(rName, version string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_cce_cluster" "test" {
+  name                   = "%s"
+  flavor_id              = "cce.s1.small"
+  cluster_version        = "%s"
+  vpc_id                 = sbercloud_vpc.test.id
+  subnet_id              = sbercloud_vpc_subnet.test.id
+  container_network_type = "overlay_l2"
+}
+`, testAccCCEClusterV3_Base(rName), rName, version)
+}
@@ -119,6 +119,44 @@ func TestAccCCENodePool_tagsLabelsTaints(t *testing.T) {
 	})
 }
 
+func TestAccCCENodePool_rollingUpdate(t *testing.T) {
+	var nodePool nodepools.NodePool
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_cce_node_pool.test"
+	//clusterName here is used to provide the cluster id to fetch cce node pool.
+	clusterName := "sbercloud_cce_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCCENodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCENodePool_rollingUpdate(rName, "c6nl.large.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCENodePoolExists(resourceName, clusterName, &nodePool),
+					resource.TestCheckResourceAttr(resourceName, "flavor_id", "c6nl.large.2"),
+					resource.TestCheckResourceAttr(resourceName, "current_node_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rolling_update.0.max_surge", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rolling_update.0.max_unavailable", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rolling_update.0.drain", "true"),
+				),
+			},
+			{
+				Config: testAccCCENodePool_rollingUpdate(rName, "c6nl.xlarge.2"),
+				Check: resource.ComposeTestCheckFunc(
+					// The nodes are replaced without re-creating the node pool.
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &nodePool.Metadata.Id),
+					testAccCheckCCENodePoolExists(resourceName, clusterName, &nodePool),
+					resource.TestCheckResourceAttr(resourceName, "flavor_id", "c6nl.xlarge.2"),
+					resource.TestCheckResourceAttr(resourceName, "current_node_count", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCCENodePoolDestroy(s *terraform.State) error {
 	config := acceptance.TestAccProvider.Meta().(*config.Config)
 	cceClient, err := config.CceV3Client(acceptance.SBC_REGION_NAME)
@@ -264,6 +302,30 @@ func testAccCCENodePool_update(rName, updateName string) string {
 	return fmt.Sprintf(`
 %s
 
+data "sbercloud_cce_addon_template" "test" {
+  cluster_id = sbercloud_cce_cluster.test.id
+  name       = "autoscaler"
+  version    = "1.23.3"
+}
+
+resource "sbercloud_cce_addon" "test" {
+  cluster_id    = sbercloud_cce_cluster.test.id
+  template_name = "autoscaler"
+  version       = "1.23.3"
+
+  values {
+    basic  = jsondecode(data.sbercloud_cce_addon_template.test.spec).basic
+    custom = merge(
+      jsondecode(data.sbercloud_cce_addon_template.test.spec).parameters.custom,
+      {
+        cluster_id = sbercloud_cce_cluster.test.id
+        tenant_id  = "%s"
+      }
+    )
+    flavor_json = jsonencode(jsondecode(data.sbercloud_cce_addon_template.test.spec).parameters.flavor1)
+  }
+}
+
 resource "sbercloud_cce_node_pool" "test" {
   cluster_id               = sbercloud_cce_cluster.test.id
   name                     = "%s"
@@ -287,8 +349,11 @@ resource "sbercloud_cce_node_pool" "test" {
     size       = 100
     volumetype = "SSD"
   }
+
+  // the autoscaler add-on must be installed before the autoscaling is enabled
+  depends_on = [sbercloud_cce_addon.test]
 }
-`, testAccCCENodePool_Base(rName), updateName)
+`, testAccCCENodePool_Base(rName), acceptance.SBC_PROJECT_ID, updateName)
 }
 
 func testAccCCENodePool_volume_extendParams(rName string) string {
@@ -437,3 +502,35 @@ resource "sbercloud_cce_node_pool" "test" {
 }
 `, testAccCCENodePool_Base(rName), rName)
 }
+
+func testAccCCENodePool_rollingUpdate(rName, flavor string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_cce_node_pool" "test" {
+  cluster_id         = sbercloud_cce_cluster.test.id
+  name               = "%s"
+  os                 = "CentOS 7.6"
+  flavor_id          = "%s"
+  initial_node_count = 2
+  availability_zone  = data.sbercloud_availability_zones.test.names[0]
+  key_pair           = sbercloud_compute_keypair.test.name
+  type               = "vm"
+
+  root_volume {
+    size       = 40
+    volumetype = "SSD"
+  }
+  data_volumes {
+    size       = 100
+    volumetype = "SSD"
+  }
+
+  rolling_update {
+    max_surge       = 1
+    max_unavailable = 0
+    drain           = true
+  }
+}
+`, testAccCCENodePool_Base(rName), rName, flavor)
+}
@@ -0,0 +1,149 @@
+package cce
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/cce/v3/partitions"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getPartitionResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.CceV3Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CCE v3 client: %s", err)
+	}
+	return partitions.Get(client, state.Primary.Attributes["cluster_id"], state.Primary.ID).Extract()
+}
+
+func TestAccCCEPartition_basic(t *testing.T) {
+	var partition partitions.Partitions
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_cce_partition.test"
+	// The availability zone of the edge partition.
+	azName := acceptance.SBC_CCE_PARTITION_AZ
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&partition,
+		getPartitionResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckCcePartitionAz(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCEPartition_basic(rName, azName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", "sbercloud_cce_cluster.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", azName),
+					resource.TestCheckResourceAttr(resourceName, "availability_zone", azName),
+					resource.TestCheckResourceAttr(resourceName, "container_subnet_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccCCEPartition_update(rName, azName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "container_subnet_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccCCEPartitionImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccCCEPartitionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["cluster_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCCEPartition_Base(rName, azName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "sbercloud_vpc_subnet" "center" {
+  name       = "%[1]s-center"
+  vpc_id     = sbercloud_vpc.test.id
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+}
+
+resource "sbercloud_vpc_subnet" "edge" {
+  count = 2
+
+  name              = "%[1]s-edge-${count.index}"
+  vpc_id            = sbercloud_vpc.test.id
+  cidr              = cidrsubnet("192.168.0.0/16", 8, count.index + 1)
+  gateway_ip        = cidrhost(cidrsubnet("192.168.0.0/16", 8, count.index + 1), 1)
+  availability_zone = "%[2]s"
+}
+
+resource "sbercloud_cce_cluster" "test" {
+  name                         = "%[1]s"
+  cluster_type                 = "VirtualMachine"
+  flavor_id                    = "cce.s1.small"
+  vpc_id                       = sbercloud_vpc.test.id
+  subnet_id                    = sbercloud_vpc_subnet.center.id
+  container_network_type       = "eni"
+  eni_subnet_id                = sbercloud_vpc_subnet.center.ipv4_subnet_id
+  eni_subnet_cidr              = sbercloud_vpc_subnet.center.cidr
+  enable_distribute_management = true
+}
+`, rName, azName)
+}
+
+func testAccCCEPartition_basic(rName, azName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_cce_partition" "test" {
+  cluster_id           = sbercloud_cce_cluster.test.id
+  category             = "IES"
+  availability_zone    = "%s"
+  partition_subnet_id  = sbercloud_vpc_subnet.edge[0].id
+  container_subnet_ids = [sbercloud_vpc_subnet.edge[0].ipv4_subnet_id]
+}
+`, testAccCCEPartition_Base(rName, azName), azName)
+}
+
+func testAccCCEPartition_update(rName, azName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_cce_partition" "test" {
+  cluster_id           = sbercloud_cce_cluster.test.id
+  category             = "IES"
+  availability_zone    = "%s"
+  partition_subnet_id  = sbercloud_vpc_subnet.edge[0].id
+  container_subnet_ids = sbercloud_vpc_subnet.edge[*].ipv4_subnet_id
+}
+`, testAccCCEPartition_Base(rName, azName), azName)
+}
@@ -3,13 +3,14 @@ package sbercloud
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/mutexkv"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/aom"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/apig"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/as"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/cbr"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/cce"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/cdm"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/cfw"
 	css_huawei "github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/css"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/cts"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dcs"
@@ -25,12 +26,15 @@ import (
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/eip"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/elb"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/eps"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/er"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/evs"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/fgs"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/gaussdb"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/ges"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/iam"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/ims"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/lb"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/lts"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/mrs"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/nat"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/obs"
@@ -39,12 +43,24 @@ import (
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/smn"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/swr"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/vpc"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/vpcep"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/vpn"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/waf"
+	aom2 "github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/aom"
+	apig2 "github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/apig"
+	cce2 "github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/cce"
 	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/ces"
 	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/css"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/dc"
 	dcs2 "github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/dcs"
 	dli2 "github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/dli"
+	dms2 "github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/dms"
 	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/drs"
-	"sync"
+	fgs2 "github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/fgs"
+	lts2 "github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/lts"
+	obs2 "github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/obs"
+	rds2 "github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/rds"
+	vpn2 "github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/services/vpn"
 )
 
 // This is a global MutexKV for use within this plugin.
@@ -57,7 +73,6 @@ func Provider() *schema.Provider {
 			"access_key": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				DefaultFunc:  schema.EnvDefaultFunc("SBC_ACCESS_KEY", nil),
 				Description:  descriptions["access_key"],
 				RequiredWith: []string{"secret_key"},
 			},
@@ -65,7 +80,6 @@ func Provider() *schema.Provider {
 			"secret_key": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				DefaultFunc:  schema.EnvDefaultFunc("SBC_SECRET_KEY", nil),
 				Description:  descriptions["secret_key"],
 				RequiredWith: []string{"access_key"},
 			},
@@ -75,39 +89,35 @@ func Provider() *schema.Provider {
 				Optional:     true,
 				Description:  descriptions["security_token"],
 				RequiredWith: []string{"access_key"},
-				DefaultFunc:  schema.EnvDefaultFunc("SBC_SECURITY_TOKEN", nil),
 			},
 
 			"auth_url": {
-				Type:     schema.TypeString,
-				Optional: true,
-				DefaultFunc: schema.EnvDefaultFunc(
-					"SBC_AUTH_URL", "https://iam.ru-moscow-1.hc.sbercloud.ru/v3"),
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: envDefaultFunc("auth_url", defaultAuthURL),
 				Description: descriptions["auth_url"],
 			},
 
 			"region": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				Description:  descriptions["region"],
-				DefaultFunc:  schema.EnvDefaultFunc("SBC_REGION_NAME", nil),
+				DefaultFunc:  envDefaultFunc("region", nil),
 				InputDefault: "ru-moscow-1",
 			},
 
 			"user_name": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				DefaultFunc:  schema.EnvDefaultFunc("SBC_USERNAME", ""),
+				DefaultFunc:  envDefaultFunc("user_name", ""),
 				Description:  descriptions["user_name"],
-				RequiredWith: []string{"password", "account_name"},
+				RequiredWith: []string{"account_name"},
 			},
 
 			"project_name": {
-				Type:     schema.TypeString,
-				Optional: true,
-				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
-					"SBC_PROJECT_NAME",
-				}, ""),
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: envDefaultFunc("project_name", ""),
 				Description: descriptions["project_name"],
 			},
 
@@ -115,7 +125,6 @@ func Provider() *schema.Provider {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Sensitive:    true,
-				DefaultFunc:  schema.EnvDefaultFunc("SBC_PASSWORD", ""),
 				Description:  descriptions["password"],
 				RequiredWith: []string{"user_name", "account_name"},
 			},
@@ -130,73 +139,98 @@ func Provider() *schema.Provider {
 							Type:        schema.TypeString,
 							Required:    true,
 							Description: descriptions["assume_role_agency_name"],
-							DefaultFunc: schema.EnvDefaultFunc("SBC_ASSUME_ROLE_AGENCY_NAME", nil),
+							DefaultFunc: envDefaultFunc("assume_role.agency_name", nil),
 						},
 						"domain_name": {
 							Type:        schema.TypeString,
 							Required:    true,
 							Description: descriptions["assume_role_domain_name"],
-							DefaultFunc: schema.EnvDefaultFunc("SBC_ASSUME_ROLE_DOMAIN_NAME", nil),
+							DefaultFunc: envDefaultFunc("assume_role.domain_name", nil),
 						},
 					},
 				},
 			},
 
 			"account_name": {
-				Type:     schema.TypeString,
-				Optional: true,
-				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
-					"SBC_ACCOUNT_NAME",
-				}, ""),
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  envDefaultFunc("account_name", ""),
 				Description:  descriptions["account_name"],
-				RequiredWith: []string{"password", "user_name"},
+				RequiredWith: []string{"user_name"},
 			},
 
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SBC_INSECURE", false),
+				DefaultFunc: envDefaultFunc("insecure", false),
 				Description: descriptions["insecure"],
 			},
 
+			"cacert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: envDefaultFunc("cacert_file", ""),
+				Description: descriptions["cacert_file"],
+			},
+
+			"cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  envDefaultFunc("cert", ""),
+				Description:  descriptions["cert"],
+				RequiredWith: []string{"key"},
+			},
+
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				DefaultFunc:  envDefaultFunc("key", ""),
+				Description:  descriptions["key"],
+				RequiredWith: []string{"cert"},
+			},
+
 			"enterprise_project_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: descriptions["enterprise_project_id"],
-				DefaultFunc: schema.EnvDefaultFunc("SBC_ENTERPRISE_PROJECT_ID", ""),
+				DefaultFunc: envDefaultFunc("enterprise_project_id", ""),
 			},
 
 			"max_retries": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Description: descriptions["max_retries"],
-				DefaultFunc: schema.EnvDefaultFunc("SBC_MAX_RETRIES", 5),
+				DefaultFunc: envDefaultFunc("max_retries", 5),
+			},
+			"shared_config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["shared_config_file"],
+				DefaultFunc: envDefaultFunc("shared_config_file", ""),
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["profile"],
+				DefaultFunc: envDefaultFunc("profile", ""),
 			},
 			"domain_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: descriptions["domain_id"],
-				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
-					"SBC_DOMAIN_ID",
-					"OS_DOMAIN_ID",
-					"OS_USER_DOMAIN_ID",
-					"OS_PROJECT_DOMAIN_ID",
-				}, ""),
+				DefaultFunc: envDefaultFunc("domain_id", ""),
 			},
 			"domain_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: descriptions["domain_name"],
-				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
-					"SBC_DOMAIN_NAME",
-					"OS_DOMAIN_NAME",
-					"OS_USER_DOMAIN_NAME",
-					"OS_PROJECT_DOMAIN_NAME",
-				}, ""),
+				DefaultFunc: envDefaultFunc("domain_name", ""),
 			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
+			"sbercloud_apig_environments":      apig.DataSourceEnvironments(),
 			"sbercloud_availability_zones":     huaweicloud.DataSourceAvailabilityZones(),
 			"sbercloud_css_flavors":            css_huawei.DataSourceCssFlavors(),
 			"sbercloud_cbr_backup":             cbr.DataSourceBackup(),
@@ -208,15 +242,18 @@ func Provider() *schema.Provider {
 			"sbercloud_cce_nodes":              cce.DataSourceNodes(),
 			"sbercloud_cce_node_pool":          cce.DataSourceCCENodePoolV3(),
 			"sbercloud_cdm_flavors":            huaweicloud.DataSourceCdmFlavorV1(),
+			"sbercloud_cfw_firewalls":          cfw.DataSourceFirewalls(),
 			"sbercloud_compute_flavors":        ecs.DataSourceEcsFlavors(),
 			"sbercloud_compute_instance":       ecs.DataSourceComputeInstance(),
 			"sbercloud_compute_instances":      ecs.DataSourceComputeInstances(),
 			"sbercloud_compute_servergroups":   ecs.DataSourceComputeServerGroups(),
+			"sbercloud_dc_connections":         dc.DataSourceDcConnections(),
 			"sbercloud_dcs_flavors":            dcs.DataSourceDcsFlavorsV2(),
 			"sbercloud_dcs_az":                 deprecated.DataSourceDcsAZV1(),
 			"sbercloud_dcs_maintainwindow":     dcs.DataSourceDcsMaintainWindow(),
 			"sbercloud_dcs_product":            deprecated.DataSourceDcsProductV1(),
 			"sbercloud_dds_flavors":            dds.DataSourceDDSFlavorV3(),
+			"sbercloud_dds_instances":          dds.DataSourceDdsInstance(),
 			"sbercloud_dms_az":                 deprecated.DataSourceDmsAZ(),
 			"sbercloud_dms_product":            dms.DataSourceDmsProduct(),
 			"sbercloud_dms_maintainwindow":     dms.DataSourceDmsMaintainWindow(),
@@ -225,7 +262,11 @@ func Provider() *schema.Provider {
 			"sbercloud_elb_flavors":            elb.DataSourceElbFlavorsV3(),
 			"sbercloud_elb_pools":              elb.DataSourcePools(),
 			"sbercloud_enterprise_project":     eps.DataSourceEnterpriseProject(),
+			"sbercloud_er_attachments":         er.DataSourceAttachments(),
+			"sbercloud_er_instances":           er.DataSourceInstances(),
+			"sbercloud_er_route_tables":        er.DataSourceRouteTables(),
 			"sbercloud_evs_volumes":            evs.DataSourceEvsVolumesV2(),
+			"sbercloud_fgs_dependencies":       fgs.DataSourceFunctionGraphDependencies(),
 			"sbercloud_identity_role":          iam.DataSourceIdentityRoleV3(),
 			"sbercloud_identity_custom_role":   iam.DataSourceIdentityCustomRole(),
 			"sbercloud_identity_group":         iam.DataSourceIdentityGroup(),
@@ -244,6 +285,7 @@ func Provider() *schema.Provider {
 			"sbercloud_networking_secgroups":   vpc.DataSourceNetworkingSecGroups(),
 			"sbercloud_obs_buckets":            obs.DataSourceObsBuckets(),
 			"sbercloud_obs_bucket_object":      obs.DataSourceObsBucketObject(),
+			"sbercloud_obs_bucket_objects":     obs2.DataSourceObsBucketObjects(),
 			"sbercloud_rds_backups":            rds.DataSourceBackup(),
 			"sbercloud_rds_flavors":            rds.DataSourceRdsFlavor(),
 			"sbercloud_rds_engine_versions":    rds.DataSourceRdsEngineVersionsV3(),
@@ -263,11 +305,36 @@ func Provider() *schema.Provider {
 			"sbercloud_vpc_subnet":             vpc.DataSourceVpcSubnetV1(),
 			"sbercloud_vpc_subnets":            vpc.DataSourceVpcSubnets(),
 			"sbercloud_vpc_subnet_ids":         vpc.DataSourceVpcSubnetIdsV1(),
+
+			"sbercloud_cce_cluster_certificate": cce2.DataSourceClusterCertificate(),
+
+			"sbercloud_gaussdb_mysql_configuration": gaussdb.DataSourceGaussdbMysqlConfigurations(),
+			"sbercloud_gaussdb_mysql_flavors":       gaussdb.DataSourceGaussdbMysqlFlavors(),
+			"sbercloud_gaussdb_mysql_instance":      gaussdb.DataSourceGaussDBMysqlInstance(),
+			"sbercloud_gaussdb_mysql_instances":     gaussdb.DataSourceGaussDBMysqlInstances(),
+			"sbercloud_gaussdb_opengauss_instance":  gaussdb.DataSourceOpenGaussInstance(),
+			"sbercloud_gaussdb_opengauss_instances": gaussdb.DataSourceOpenGaussInstances(),
+
+			"sbercloud_vpcep_public_services": vpcep.DataSourceVPCEPPublicServices(),
+
+			"sbercloud_vpn_gateway_availability_zones": vpn2.DataSourceVpnGatewayAZs(),
+			"sbercloud_vpn_gateway_flavors":            vpn2.DataSourceVpnGatewayFlavors(),
+
+			"sbercloud_waf_certificate":         waf.DataSourceWafCertificateV1(),
+			"sbercloud_waf_dedicated_instances": waf.DataSourceWafDedicatedInstancesV1(),
+			"sbercloud_waf_instance_groups":     waf.DataSourceWafInstanceGroups(),
+			"sbercloud_waf_policies":            waf.DataSourceWafPoliciesV1(),
+			"sbercloud_waf_reference_tables":    waf.DataSourceWafReferenceTablesV1(),
 			// Legacy
 			"sbercloud_identity_role_v3": iam.DataSourceIdentityRoleV3(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			"sbercloud_aom_alarm_action_rule":           aom.ResourceAlarmActionRule(),
+			"sbercloud_aom_alarm_rule":                  aom.ResourceAlarmRule(),
+			"sbercloud_aom_alarm_silence_rule":          aom.ResourceAlarmSilenceRule(),
+			"sbercloud_aom_event_alarm_rule":            aom.ResourceEventAlarmRule(),
+			"sbercloud_aom_prom_instance":               aom2.ResourcePromInstance(),
 			"sbercloud_aom_service_discovery_rule":      aom.ResourceServiceDiscoveryRule(),
 			"sbercloud_api_gateway_api":                 huaweicloud.ResourceAPIGatewayAPI(),
 			"sbercloud_api_gateway_group":               huaweicloud.ResourceAPIGatewayGroup(),
@@ -278,13 +345,21 @@ func Provider() *schema.Provider {
 			"sbercloud_cbr_vault":                       cbr.ResourceVault(),
 			"sbercloud_css_cluster":                     css.ResourceCssCluster(),
 			"sbercloud_cce_addon":                       cce.ResourceAddon(),
-			"sbercloud_cce_cluster":                     cce.ResourceCluster(),
+			"sbercloud_cce_autoscaling_policy":          cce2.ResourceAutoscalingPolicy(),
+			"sbercloud_cce_cluster":                     cce2.ResourceCluster(),
 			"sbercloud_cce_namespace":                   cce.ResourceCCENamespaceV1(),
 			"sbercloud_cce_node":                        cce.ResourceNode(),
 			"sbercloud_cce_node_attach":                 cce.ResourceNodeAttach(),
-			"sbercloud_cce_node_pool":                   cce.ResourceNodePool(),
+			"sbercloud_cce_node_pool":                   cce2.ResourceNodePool(),
+			"sbercloud_cce_partition":                   cce2.ResourcePartition(),
 			"sbercloud_cce_pvc":                         cce.ResourceCcePersistentVolumeClaimsV1(),
 			"sbercloud_cdm_cluster":                     cdm.ResourceCdmCluster(),
+			"sbercloud_cfw_address_group":               cfw.ResourceAddressGroup(),
+			"sbercloud_cfw_address_group_member":        cfw.ResourceAddressGroupMember(),
+			"sbercloud_cfw_black_white_list":            cfw.ResourceBlackWhiteList(),
+			"sbercloud_cfw_protection_rule":             cfw.ResourceProtectionRule(),
+			"sbercloud_cfw_service_group":               cfw.ResourceServiceGroup(),
+			"sbercloud_cfw_service_group_member":        cfw.ResourceServiceGroupMember(),
 			"sbercloud_compute_instance":                ResourceComputeInstanceV2(),
 			"sbercloud_compute_interface_attach":        ecs.ResourceComputeInterfaceAttach(),
 			"sbercloud_compute_keypair":                 huaweicloud.ResourceComputeKeypairV2(),
@@ -295,20 +370,37 @@ func Provider() *schema.Provider {
 			"sbercloud_cts_tracker":                     cts.ResourceCTSTracker(),
 			"sbercloud_cts_data_tracker":                cts.ResourceCTSDataTracker(),
 			"sbercloud_cts_notification":                cts.ResourceCTSNotification(),
+			"sbercloud_dc_virtual_gateway":              dc.ResourceVirtualGateway(),
+			"sbercloud_dc_virtual_interface":            dc.ResourceVirtualInterface(),
 			"sbercloud_dcs_instance":                    dcs.ResourceDcsInstance(),
 			"sbercloud_dcs_backup":                      dcs.ResourceDcsBackup(),
 			"sbercloud_dcs_restore":                     dcs2.ResourceDcsRestore(),
 			"sbercloud_dcs_parameters":                  dcs2.ResourceDcsParameters(),
 			"sbercloud_dds_instance":                    dds.ResourceDdsInstanceV3(),
+			"sbercloud_dds_database_user":               dds.ResourceDatabaseUser(),
+			"sbercloud_dds_database_role":               dds.ResourceDatabaseRole(),
+			"sbercloud_dds_backup":                      dds.ResourceDdsBackup(),
+			"sbercloud_dds_parameter_template":          dds.ResourceDdsParameterTemplate(),
+			"sbercloud_dds_audit_log_policy":            dds.ResourceDdsAuditLogPolicy(),
 			"sbercloud_dis_stream":                      dis.ResourceDisStream(),
 			"sbercloud_dli_database":                    dli.ResourceDliSqlDatabaseV1(),
 			"sbercloud_dli_package":                     dli.ResourceDliPackageV2(),
 			"sbercloud_dli_queue":                       dli.ResourceDliQueue(),
 			"sbercloud_dli_spark_job":                   dli2.ResourceDliSparkJob(),
 			"sbercloud_dms_instance":                    ResourceDmsInstancesV1(),
+			"sbercloud_dms_kafka_consumer_group":        dms2.ResourceDmsKafkaConsumerGroup(),
 			"sbercloud_dms_kafka_instance":              dms.ResourceDmsKafkaInstance(),
-			"sbercloud_dms_kafka_topic":                 dms.ResourceDmsKafkaTopic(),
+			"sbercloud_dms_kafka_permissions":           dms.ResourceDmsKafkaPermissions(),
+			"sbercloud_dms_kafka_smart_connect":         dms2.ResourceDmsKafkaSmartConnect(),
+			"sbercloud_dms_kafka_smart_connect_task":    dms2.ResourceDmsKafkaSmartConnectTask(),
+			"sbercloud_dms_kafka_topic":                 dms2.ResourceDmsKafkaTopic(),
+			"sbercloud_dms_kafka_user":                  dms.ResourceDmsKafkaUser(),
+			"sbercloud_dms_rabbitmq_exchange":           dms2.ResourceDmsRabbitmqExchange(),
+			"sbercloud_dms_rabbitmq_exchange_binding":   dms2.ResourceDmsRabbitmqExchangeBinding(),
 			"sbercloud_dms_rabbitmq_instance":           dms.ResourceDmsRabbitmqInstance(),
+			"sbercloud_dms_rabbitmq_queue":              dms2.ResourceDmsRabbitmqQueue(),
+			"sbercloud_dms_rabbitmq_user":               dms2.ResourceDmsRabbitmqUser(),
+			"sbercloud_dms_rabbitmq_vhost":              dms2.ResourceDmsRabbitmqVhost(),
 			"sbercloud_dns_recordset":                   dns.ResourceDNSRecordSetV2(),
 			"sbercloud_dns_zone":                        dns.ResourceDNSZone(),
 			"sbercloud_drs_job":                         drs.ResourceDrsJob(),
@@ -318,15 +410,27 @@ func Provider() *schema.Provider {
 			"sbercloud_elb_l7rule":                      elb.ResourceL7RuleV3(),
 			"sbercloud_elb_listener":                    elb.ResourceListenerV3(),
 			"sbercloud_elb_loadbalancer":                elb.ResourceLoadBalancerV3(),
+			"sbercloud_elb_log":                         lts.ResourceLtsElb(),
 			"sbercloud_elb_monitor":                     elb.ResourceMonitorV3(),
 			"sbercloud_elb_ipgroup":                     elb.ResourceIpGroupV3(),
 			"sbercloud_elb_pool":                        elb.ResourcePoolV3(),
 			"sbercloud_elb_member":                      elb.ResourceMemberV3(),
 			"sbercloud_elb_security_policy":             elb.ResourceSecurityPolicy(),
 			"sbercloud_enterprise_project":              eps.ResourceEnterpriseProject(),
+			"sbercloud_er_association":                  er.ResourceAssociation(),
+			"sbercloud_er_instance":                     er.ResourceInstance(),
+			"sbercloud_er_propagation":                  er.ResourcePropagation(),
+			"sbercloud_er_route_table":                  er.ResourceRouteTable(),
+			"sbercloud_er_static_route":                 er.ResourceStaticRoute(),
+			"sbercloud_er_vpc_attachment":               er.ResourceVpcAttachment(),
 			"sbercloud_evs_snapshot":                    huaweicloud.ResourceEvsSnapshotV2(),
 			"sbercloud_evs_volume":                      evs.ResourceEvsVolume(),
+			"sbercloud_fgs_async_invoke_configuration":  fgs.ResourceAsyncInvokeConfiguration(),
+			"sbercloud_fgs_dependency":                  fgs.ResourceFgsDependency(),
 			"sbercloud_fgs_function":                    fgs.ResourceFgsFunctionV2(),
+			"sbercloud_fgs_function_alias":              fgs2.ResourceFgsFunctionAlias(),
+			"sbercloud_fgs_function_version":            fgs2.ResourceFgsFunctionVersion(),
+			"sbercloud_fgs_trigger":                     fgs.ResourceFunctionGraphTrigger(),
 			"sbercloud_ges_graph":                       ges.ResourceGesGraph(),
 			"sbercloud_identity_access_key":             iam.ResourceIdentityKey(),
 			"sbercloud_identity_acl":                    iam.ResourceIdentityACL(),
@@ -350,8 +454,13 @@ func Provider() *schema.Provider {
 			"sbercloud_lb_monitor":                      lb.ResourceMonitorV2(),
 			"sbercloud_lb_pool":                         lb.ResourcePoolV2(),
 			"sbercloud_lb_whitelist":                    lb.ResourceWhitelistV2(),
+			"sbercloud_lts_access_rule":                 lts.ResourceAomMappingRule(),
 			"sbercloud_lts_group":                       huaweicloud.ResourceLTSGroupV2(),
+			"sbercloud_lts_host_group":                  lts.ResourceHostGroup(),
+			"sbercloud_lts_keywords_alarm_rule":         lts2.ResourceLtsKeywordsAlarmRule(),
 			"sbercloud_lts_stream":                      huaweicloud.ResourceLTSStreamV2(),
+			"sbercloud_lts_struct_template":             lts.ResourceLtsStruct(),
+			"sbercloud_lts_transfer":                    lts2.ResourceLtsTransfer(),
 			"sbercloud_mapreduce_cluster":               mrs.ResourceMRSClusterV2(),
 			"sbercloud_mapreduce_job":                   mrs.ResourceMRSJobV2(),
 			"sbercloud_nat_dnat_rule":                   nat.ResourcePublicDnatRule(),
@@ -368,10 +477,23 @@ func Provider() *schema.Provider {
 			"sbercloud_obs_bucket_object":               obs.ResourceObsBucketObject(),
 			"sbercloud_obs_bucket_policy":               obs.ResourceObsBucketPolicy(),
 			"sbercloud_obs_bucket_acl":                  obs.ResourceOBSBucketAcl(),
+			"sbercloud_obs_bucket_inventory":            obs2.ResourceObsBucketInventory(),
+			"sbercloud_obs_bucket_notification":         obs2.ResourceObsBucketNotification(),
+			"sbercloud_obs_bucket_object_lock":          obs2.ResourceObsBucketObjectLock(),
+			"sbercloud_obs_bucket_replication":          obs.ResourceObsBucketReplication(),
 			"sbercloud_rds_instance":                    rds.ResourceRdsInstance(),
-			"sbercloud_rds_parametergroup":              rds.ResourceRdsConfiguration(),
+			"sbercloud_rds_parametergroup":              rds2.ResourceRdsConfiguration(),
 			"sbercloud_rds_backup":                      rds.ResourceBackup(),
 			"sbercloud_rds_read_replica_instance":       rds.ResourceRdsReadReplicaInstance(),
+			"sbercloud_rds_mysql_account":               rds.ResourceRdsAccount(),
+			"sbercloud_rds_mysql_database":              rds.ResourceRdsDatabase(),
+			"sbercloud_rds_mysql_database_privilege":    rds.ResourceRdsDatabasePrivilege(),
+			"sbercloud_rds_pg_account":                  rds2.ResourceRdsPgAccount(),
+			"sbercloud_rds_pg_database":                 rds2.ResourceRdsPgDatabase(),
+			"sbercloud_rds_pg_database_privilege":       rds2.ResourceRdsPgDatabasePrivilege(),
+			"sbercloud_rds_sql_audit":                   rds2.ResourceRdsSQLAudit(),
+			"sbercloud_rds_mysql_binlog":                rds2.ResourceRdsMysqlBinlog(),
+			"sbercloud_rds_cross_region_backup":         rds2.ResourceRdsCrossRegionBackup(),
 			"sbercloud_sfs_access_rule":                 huaweicloud.ResourceSFSAccessRuleV2(),
 			"sbercloud_sfs_file_system":                 huaweicloud.ResourceSFSFileSystemV2(),
 			"sbercloud_sfs_turbo":                       huaweicloud.ResourceSFSTurbo(),
@@ -389,6 +511,54 @@ func Provider() *schema.Provider {
 			"sbercloud_vpc_route_table":                 vpc.ResourceVPCRouteTable(),
 			"sbercloud_vpc_subnet":                      vpc.ResourceVpcSubnetV1(),
 			"sbercloud_vpc_address_group":               vpc.ResourceVpcAddressGroup(),
+
+			"sbercloud_apig_api":                         apig.ResourceApigAPIV2(),
+			"sbercloud_apig_api_publishment":             apig.ResourceApigApiPublishment(),
+			"sbercloud_apig_application":                 apig.ResourceApigApplicationV2(),
+			"sbercloud_apig_application_authorization":   apig2.ResourceApplicationAuthorization(),
+			"sbercloud_apig_channel":                     apig.ResourceChannel(),
+			"sbercloud_apig_custom_authorizer":           apig.ResourceApigCustomAuthorizerV2(),
+			"sbercloud_apig_environment":                 apig.ResourceApigEnvironmentV2(),
+			"sbercloud_apig_environment_variable":        apig2.ResourceEnvironmentVariable(),
+			"sbercloud_apig_group":                       apig.ResourceApigGroupV2(),
+			"sbercloud_apig_instance":                    apig.ResourceApigInstanceV2(),
+			"sbercloud_apig_response":                    apig.ResourceApigResponseV2(),
+			"sbercloud_apig_throttling_policy":           apig.ResourceApigThrottlingPolicyV2(),
+			"sbercloud_apig_throttling_policy_associate": apig.ResourceThrottlingPolicyAssociate(),
+
+			"sbercloud_gaussdb_mysql_instance":           gaussdb.ResourceGaussDBInstance(),
+			"sbercloud_gaussdb_mysql_proxy":              gaussdb.ResourceGaussDBProxy(),
+			"sbercloud_gaussdb_mysql_sql_control_rule":   gaussdb.ResourceGaussDBSqlControlRule(),
+			"sbercloud_gaussdb_mysql_parameter_template": gaussdb.ResourceGaussDBMysqlTemplate(),
+			"sbercloud_gaussdb_opengauss_instance":       gaussdb.ResourceOpenGaussInstance(),
+
+			"sbercloud_vpcep_approval": vpcep.ResourceVPCEndpointApproval(),
+			"sbercloud_vpcep_endpoint": vpcep.ResourceVPCEndpoint(),
+			"sbercloud_vpcep_service":  vpcep.ResourceVPCEndpointService(),
+
+			"sbercloud_vpn_gateway":                 vpn.ResourceGateway(),
+			"sbercloud_vpn_customer_gateway":        vpn.ResourceCustomerGateway(),
+			"sbercloud_vpn_connection":              vpn.ResourceConnection(),
+			"sbercloud_vpn_connection_health_check": vpn.ResourceConnectionHealthCheck(),
+
+			"sbercloud_waf_address_group":                    waf.ResourceWafAddressGroup(),
+			"sbercloud_waf_certificate":                      waf.ResourceWafCertificateV1(),
+			"sbercloud_waf_cloud_instance":                   waf.ResourceCloudInstance(),
+			"sbercloud_waf_dedicated_domain":                 waf.ResourceWafDedicatedDomainV1(),
+			"sbercloud_waf_dedicated_instance":               waf.ResourceWafDedicatedInstance(),
+			"sbercloud_waf_domain":                           waf.ResourceWafDomainV1(),
+			"sbercloud_waf_instance_group":                   waf.ResourceWafInstanceGroup(),
+			"sbercloud_waf_instance_group_associate":         waf.ResourceWafInstGroupAssociate(),
+			"sbercloud_waf_policy":                           waf.ResourceWafPolicyV1(),
+			"sbercloud_waf_reference_table":                  waf.ResourceWafReferenceTableV1(),
+			"sbercloud_waf_rule_blacklist":                   waf.ResourceWafRuleBlackListV1(),
+			"sbercloud_waf_rule_cc_protection":               waf.ResourceRuleCCProtection(),
+			"sbercloud_waf_rule_data_masking":                waf.ResourceWafRuleDataMaskingV1(),
+			"sbercloud_waf_rule_geolocation_access_control":  waf.ResourceRuleGeolocation(),
+			"sbercloud_waf_rule_global_protection_whitelist": waf.ResourceRuleGlobalProtectionWhitelist(),
+			"sbercloud_waf_rule_known_attack_source":         waf.ResourceRuleKnownAttack(),
+			"sbercloud_waf_rule_precise_protection":          waf.ResourceRulePreciseProtection(),
+			"sbercloud_waf_rule_web_tamper_protection":       waf.ResourceWafRuleWebTamperProtectionV1(),
 			// Legacy
 			"sbercloud_identity_role_assignment_v3":  iam.ResourceIdentityGroupRoleAssignment(),
 			"sbercloud_identity_user_v3":             iam.ResourceIdentityUser(),
@@ -433,37 +603,39 @@ func init() {
 		"account_name": "The name of the Account to login with.",
 
 		"insecure": "Trust self-signed certificates.",
+
+		"cacert_file": "A Custom CA certificate, the path or the PEM contents of it.",
+
+		"cert": "A client certificate to authenticate with, the path or the PEM contents of it.",
+
+		"key": "A client private key to authenticate with, the path or the PEM contents of it.",
+
+		"enterprise_project_id": "The default Enterprise Project ID for supported resources.",
+
+		"max_retries": "The maximum number of times an API call is retried.",
+
+		"domain_id": "The ID of the Domain to scope to.",
+
+		"domain_name": "The name of the Domain to scope to, an alias of account_name.",
+
+		"shared_config_file": "The path to the shared config file. If not set, the default is ~/.sbercloud/config.json.",
+
+		"profile": "The profile name as set in the shared config file.",
+
+		"assume_role_agency_name": "The name of the agency for assume role.",
+
+		"assume_role_domain_name": "The name of the agency domain for assume role.",
 	}
 }
 
 func configureProvider(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
-	var project_name string
-
-	// Use region as project_name if it's not set
-	if v, ok := d.GetOk("project_name"); ok && v.(string) != "" {
-		project_name = v.(string)
-	} else {
-		project_name = d.Get("region").(string)
+	config, err := buildConfig(d, terraformVersion)
+	if err != nil {
+		return nil, err
 	}
 
-	config := config.Config{
-		AccessKey:           d.Get("access_key").(string),
-		SecretKey:           d.Get("secret_key").(string),
-		SecurityToken:       d.Get("security_token").(string),
-		DomainName:          d.Get("account_name").(string),
-		IdentityEndpoint:    d.Get("auth_url").(string),
-		Insecure:            d.Get("insecure").(bool),
-		Password:            d.Get("password").(string),
-		Region:              d.Get("region").(string),
-		TenantName:          project_name,
-		Username:            d.Get("user_name").(string),
-		TerraformVersion:    terraformVersion,
-		Cloud:               "hc.sbercloud.ru",
-		MaxRetries:          d.Get("max_retries").(int),
-		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
-		RegionClient:        true,
-		RegionProjectIDMap:  make(map[string]string),
-		RPLock:              new(sync.Mutex),
+	if err := applyMetadataCredentials(config); err != nil {
+		return nil, err
 	}
 
 	if err := config.LoadAndValidate(); err != nil {
@@ -474,5 +646,5 @@ func configureProvider(d *schema.ResourceData, terraformVersion string) (interfa
 		config.RegionProjectIDMap[config.Region] = config.HwClient.ProjectID
 	}
 
-	return &config, nil
+	return config, nil
 }
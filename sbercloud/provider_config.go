@@ -0,0 +1,286 @@
+package sbercloud
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/pathorcontents"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	defaultCloud            = "hc.sbercloud.ru"
+	defaultAuthURL          = "https://iam.ru-moscow-1.hc.sbercloud.ru/v3"
+	defaultSharedConfigFile = "~/.sbercloud/config.json"
+)
+
+// envSetting describes the environment variable backing a setting and the
+// deprecated variables which are still honored for backward compatibility.
+type envSetting struct {
+	Name       string
+	Deprecated []string
+}
+
+// envSettings maps every setting which can be sourced from the environment
+// to its documented SBC_* variable.
+var envSettings = map[string]envSetting{
+	"access_key":              {Name: "SBC_ACCESS_KEY"},
+	"secret_key":              {Name: "SBC_SECRET_KEY"},
+	"security_token":          {Name: "SBC_SECURITY_TOKEN"},
+	"auth_url":                {Name: "SBC_AUTH_URL"},
+	"region":                  {Name: "SBC_REGION_NAME"},
+	"user_name":               {Name: "SBC_USERNAME"},
+	"password":                {Name: "SBC_PASSWORD"},
+	"project_name":            {Name: "SBC_PROJECT_NAME"},
+	"account_name":            {Name: "SBC_ACCOUNT_NAME"},
+	"insecure":                {Name: "SBC_INSECURE"},
+	"cacert_file":             {Name: "SBC_CACERT_FILE"},
+	"cert":                    {Name: "SBC_CERT"},
+	"key":                     {Name: "SBC_KEY"},
+	"enterprise_project_id":   {Name: "SBC_ENTERPRISE_PROJECT_ID"},
+	"max_retries":             {Name: "SBC_MAX_RETRIES"},
+	"shared_config_file":      {Name: "SBC_SHARED_CONFIG_FILE"},
+	"profile":                 {Name: "SBC_PROFILE"},
+	"assume_role.agency_name": {Name: "SBC_ASSUME_ROLE_AGENCY_NAME"},
+	"assume_role.domain_name": {Name: "SBC_ASSUME_ROLE_DOMAIN_NAME"},
+	"compute_instance.image_id": {
+		Name: "SBC_IMAGE_ID", Deprecated: []string{"HW_IMAGE_ID"},
+	},
+	"compute_instance.image_name": {
+		Name: "SBC_IMAGE_NAME", Deprecated: []string{"HW_IMAGE_NAME"},
+	},
+	"compute_instance.flavor_id": {
+		Name: "SBC_FLAVOR_ID", Deprecated: []string{"HW_FLAVOR_ID"},
+	},
+	"compute_instance.flavor_name": {
+		Name: "SBC_FLAVOR_NAME", Deprecated: []string{"HW_FLAVOR_NAME"},
+	},
+	"domain_id": {
+		Name:       "SBC_DOMAIN_ID",
+		Deprecated: []string{"OS_DOMAIN_ID", "OS_USER_DOMAIN_ID", "OS_PROJECT_DOMAIN_ID"},
+	},
+	"domain_name": {
+		Name:       "SBC_DOMAIN_NAME",
+		Deprecated: []string{"OS_DOMAIN_NAME", "OS_USER_DOMAIN_NAME", "OS_PROJECT_DOMAIN_NAME"},
+	},
+}
+
+// lookupEnv returns the value of the environment variable backing the setting.
+// The documented SBC_* variable always wins, deprecated aliases are only used
+// when it is unset and a warning is logged for them.
+func lookupEnv(setting string) (string, bool) {
+	env, ok := envSettings[setting]
+	if !ok {
+		return "", false
+	}
+
+	if v := os.Getenv(env.Name); v != "" {
+		return v, true
+	}
+	for _, alias := range env.Deprecated {
+		if v := os.Getenv(alias); v != "" {
+			log.Printf("[WARN] the environment variable %s is deprecated and will be removed in a future release, "+
+				"please use %s instead", alias, env.Name)
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// envDefaultFunc is a helper function that returns the value of the environment
+// variable backing the setting, or the default value if it is not set.
+func envDefaultFunc(setting string, dv interface{}) schema.SchemaDefaultFunc {
+	return func() (interface{}, error) {
+		if v, ok := lookupEnv(setting); ok {
+			return v, nil
+		}
+		return dv, nil
+	}
+}
+
+// buildConfig resolves the provider arguments into a config.Config without
+// sending any request, the credentials are taken from the first source of the
+// chain which provides them: static, profile, environment variables.
+// LoadAndValidate must be called to build the clients.
+func buildConfig(d *schema.ResourceData, terraformVersion string) (*config.Config, error) {
+	var projectName, domainName string
+
+	// Use region as project_name if it's not set
+	if v, ok := d.GetOk("project_name"); ok && v.(string) != "" {
+		projectName = v.(string)
+	} else {
+		projectName = d.Get("region").(string)
+	}
+
+	// account_name is the name of the IAM domain, domain_name is an alias of it
+	if v, ok := d.GetOk("account_name"); ok && v.(string) != "" {
+		domainName = v.(string)
+	} else {
+		domainName = d.Get("domain_name").(string)
+	}
+
+	conf := config.Config{
+		AccessKey:           d.Get("access_key").(string),
+		SecretKey:           d.Get("secret_key").(string),
+		SecurityToken:       d.Get("security_token").(string),
+		CACertFile:          d.Get("cacert_file").(string),
+		ClientCertFile:      d.Get("cert").(string),
+		ClientKeyFile:       d.Get("key").(string),
+		DomainID:            d.Get("domain_id").(string),
+		DomainName:          domainName,
+		IdentityEndpoint:    d.Get("auth_url").(string),
+		Insecure:            d.Get("insecure").(bool),
+		Password:            d.Get("password").(string),
+		Region:              d.Get("region").(string),
+		TenantName:          projectName,
+		Username:            d.Get("user_name").(string),
+		TerraformVersion:    terraformVersion,
+		Cloud:               defaultCloud,
+		MaxRetries:          d.Get("max_retries").(int),
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
+		RegionClient:        true,
+		RegionProjectIDMap:  make(map[string]string),
+		RPLock:              new(sync.Mutex),
+		SecurityKeyLock:     new(sync.Mutex),
+	}
+
+	if err := validateTLSConfig(&conf); err != nil {
+		return nil, err
+	}
+
+	// get assume role
+	assumeRoleList := d.Get("assume_role").([]interface{})
+	if len(assumeRoleList) == 1 && assumeRoleList[0] != nil {
+		assumeRole := assumeRoleList[0].(map[string]interface{})
+		conf.AssumeRoleAgency = assumeRole["agency_name"].(string)
+		conf.AssumeRoleDomain = assumeRole["domain_name"].(string)
+	}
+
+	if err := applySharedProfile(d, &conf); err != nil {
+		return nil, err
+	}
+	applyEnvCredentials(&conf)
+
+	if conf.Region == "" {
+		return nil, fmt.Errorf("region must be set in the provider block, the SBC_REGION_NAME environment variable " +
+			"or the profile of the shared config file")
+	}
+
+	return &conf, nil
+}
+
+// applySharedProfile fills the credentials and the other settings which are not
+// specified in the provider block with the selected profile of the shared config file.
+func applySharedProfile(d *schema.ResourceData, conf *config.Config) error {
+	profileName := d.Get("profile").(string)
+	configFile := d.Get("shared_config_file").(string)
+	if configFile == "" {
+		// the shared config file is only used when a profile is specified
+		if profileName == "" {
+			return nil
+		}
+		configFile = defaultSharedConfigFile
+	}
+
+	profile, err := readSharedProfile(configFile, profileName)
+	if err != nil {
+		return err
+	}
+
+	// the static credentials always take precedence over the profile
+	if conf.AccessKey == "" && conf.SecretKey == "" && conf.Password == "" {
+		conf.AccessKey = profile.AccessKeyId
+		conf.SecretKey = profile.SecretAccessKey
+		conf.SecurityToken = profile.SecurityToken
+	}
+	if conf.Region == "" {
+		conf.Region = profile.Region
+		if _, ok := d.GetOk("project_name"); !ok {
+			conf.TenantName = profile.Region
+		}
+	}
+	if conf.TenantID == "" {
+		conf.TenantID = profile.ProjectId
+	}
+	if conf.DomainID == "" {
+		conf.DomainID = profile.DomainId
+	}
+	if conf.AssumeRoleAgency == "" && profile.AgencyName != "" {
+		conf.AssumeRoleAgency = profile.AgencyName
+		conf.AssumeRoleDomain = profile.AgencyDomainName
+	}
+
+	// SharedConfigFile is left empty on purpose, the profile has been resolved here so
+	// that the static credentials keep taking precedence over it
+	log.Printf("[DEBUG] using the profile %s of the shared config file %s", profile.Name, configFile)
+	return nil
+}
+
+// readSharedProfile reads the profile from the shared config file, the current
+// profile of the file is used if the profile name is empty.
+func readSharedProfile(configFile, profileName string) (*config.Profile, error) {
+	path := expandPath(configFile)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the shared config file %s: %s", path, err)
+	}
+
+	var sharedConfig config.SharedConfig
+	if err := json.Unmarshal(data, &sharedConfig); err != nil {
+		return nil, fmt.Errorf("error parsing the shared config file %s: %s", path, err)
+	}
+
+	if profileName == "" {
+		profileName = sharedConfig.Current
+	}
+	for _, profile := range sharedConfig.Profiles {
+		if profile.Name == profileName {
+			return &profile, nil
+		}
+	}
+	return nil, fmt.Errorf("unable to find the profile %q in the shared config file %s", profileName, path)
+}
+
+// validateTLSConfig checks the custom CA certificate and the client certificate, which
+// can be specified as the path or the PEM contents, before building the clients.
+func validateTLSConfig(conf *config.Config) error {
+	if conf.CACertFile != "" {
+		caCert, _, err := pathorcontents.Read(conf.CACertFile)
+		if err != nil {
+			return fmt.Errorf("error reading cacert_file: %s", err)
+		}
+		if !x509.NewCertPool().AppendCertsFromPEM([]byte(caCert)) {
+			return fmt.Errorf("no valid PEM certificate was found in cacert_file")
+		}
+	}
+
+	if conf.ClientCertFile != "" || conf.ClientKeyFile != "" {
+		clientCert, _, err := pathorcontents.Read(conf.ClientCertFile)
+		if err != nil {
+			return fmt.Errorf("error reading cert: %s", err)
+		}
+		clientKey, _, err := pathorcontents.Read(conf.ClientKeyFile)
+		if err != nil {
+			return fmt.Errorf("error reading key: %s", err)
+		}
+		if _, err := tls.X509KeyPair([]byte(clientCert), []byte(clientKey)); err != nil {
+			return fmt.Errorf("error loading the client certificate: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func expandPath(path string) string {
+	if expanded, err := homedir.Expand(path); err == nil {
+		return expanded
+	}
+	return path
+}
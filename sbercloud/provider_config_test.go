@@ -0,0 +1,532 @@
+package sbercloud
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestLookupEnv(t *testing.T) {
+	t.Setenv("SBC_DOMAIN_ID", "")
+	t.Setenv("OS_DOMAIN_ID", "")
+	t.Setenv("OS_USER_DOMAIN_ID", "legacy-domain-id")
+
+	if v, ok := lookupEnv("domain_id"); !ok || v != "legacy-domain-id" {
+		t.Fatalf("expected the deprecated alias to be used, got %q", v)
+	}
+
+	t.Setenv("SBC_DOMAIN_ID", "domain-id")
+	if v, ok := lookupEnv("domain_id"); !ok || v != "domain-id" {
+		t.Fatalf("expected SBC_DOMAIN_ID to take precedence, got %q", v)
+	}
+
+	if _, ok := lookupEnv("unknown_setting"); ok {
+		t.Fatal("expected an unknown setting not to be resolved")
+	}
+}
+
+func TestEnvDefaultFunc(t *testing.T) {
+	t.Setenv("SBC_IMAGE_ID", "")
+	t.Setenv("HW_IMAGE_ID", "legacy-image-id")
+
+	v, err := envDefaultFunc("compute_instance.image_id", nil)()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if v != "legacy-image-id" {
+		t.Fatalf("expected HW_IMAGE_ID to be used as a fallback, got %v", v)
+	}
+
+	t.Setenv("HW_IMAGE_ID", "")
+	v, err = envDefaultFunc("compute_instance.image_id", "default")()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if v != "default" {
+		t.Fatalf("expected the default value, got %v", v)
+	}
+}
+
+// TestEnvSettings_registered makes sure that every setting passed to envDefaultFunc
+// is backed by a documented SBC_* environment variable.
+func TestEnvSettings_registered(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatalf("Error listing the source files: %s", err)
+	}
+
+	settingRegex := regexp.MustCompile(`envDefaultFunc\("([^"]+)"`)
+	var count int
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("Error reading %s: %s", file, err)
+		}
+		for _, match := range settingRegex.FindAllStringSubmatch(string(content), -1) {
+			count++
+			env, ok := envSettings[match[1]]
+			if !ok {
+				t.Errorf("the setting %s used in %s has no environment variable in envSettings", match[1], file)
+				continue
+			}
+			if !strings.HasPrefix(env.Name, "SBC_") {
+				t.Errorf("the environment variable of %s should start with SBC_, got %q", match[1], env.Name)
+			}
+		}
+	}
+	if count == 0 {
+		t.Fatal("no setting passed to envDefaultFunc was found")
+	}
+}
+
+func TestBuildConfig(t *testing.T) {
+	raw := map[string]interface{}{
+		"region":      "ru-moscow-1",
+		"access_key":  "access-key",
+		"secret_key":  "secret-key",
+		"domain_id":   "domain-id",
+		"domain_name": "domain-name",
+		"max_retries": 3,
+		"assume_role": []interface{}{
+			map[string]interface{}{
+				"agency_name": "agency",
+				"domain_name": "agency-domain",
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+
+	conf, err := buildConfig(d, "1.0.0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if conf.DomainID != "domain-id" {
+		t.Errorf("expected DomainID to be domain-id, got %s", conf.DomainID)
+	}
+	if conf.DomainName != "domain-name" {
+		t.Errorf("expected DomainName to be domain-name, got %s", conf.DomainName)
+	}
+	if conf.TenantName != "ru-moscow-1" {
+		t.Errorf("expected TenantName to default to the region, got %s", conf.TenantName)
+	}
+	if conf.Cloud != defaultCloud {
+		t.Errorf("expected Cloud to be %s, got %s", defaultCloud, conf.Cloud)
+	}
+	if conf.MaxRetries != 3 {
+		t.Errorf("expected MaxRetries to be 3, got %d", conf.MaxRetries)
+	}
+	if conf.AssumeRoleAgency != "agency" || conf.AssumeRoleDomain != "agency-domain" {
+		t.Errorf("expected assume role to be agency/agency-domain, got %s/%s",
+			conf.AssumeRoleAgency, conf.AssumeRoleDomain)
+	}
+}
+
+func TestBuildConfig_accountName(t *testing.T) {
+	raw := map[string]interface{}{
+		"region":       "ru-moscow-1",
+		"user_name":    "user",
+		"password":     "password",
+		"account_name": "account",
+		"domain_name":  "domain-name",
+		"project_name": "ru-moscow-1_project",
+	}
+	d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+
+	conf, err := buildConfig(d, "1.0.0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if conf.DomainName != "account" {
+		t.Errorf("expected account_name to take precedence over domain_name, got %s", conf.DomainName)
+	}
+	if conf.TenantName != "ru-moscow-1_project" {
+		t.Errorf("expected TenantName to be ru-moscow-1_project, got %s", conf.TenantName)
+	}
+}
+
+const testSharedConfig = `{
+  "current": "dev",
+  "profiles": [
+    {
+      "name": "dev",
+      "mode": "AKSK",
+      "accessKeyId": "dev-access-key",
+      "secretAccessKey": "dev-secret-key",
+      "region": "ru-moscow-1"
+    },
+    {
+      "name": "prod",
+      "mode": "AKSK",
+      "accessKeyId": "prod-access-key",
+      "secretAccessKey": "prod-secret-key",
+      "securityToken": "prod-security-token",
+      "region": "ru-moscow-1",
+      "domainId": "prod-domain-id"
+    }
+  ]
+}`
+
+func testSharedConfigFile(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(testSharedConfig), 0600); err != nil {
+		t.Fatalf("Error writing the shared config file: %s", err)
+	}
+	return path
+}
+
+func TestBuildConfig_profile(t *testing.T) {
+	raw := map[string]interface{}{
+		"shared_config_file": testSharedConfigFile(t),
+		"profile":            "prod",
+	}
+	d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+
+	conf, err := buildConfig(d, "1.0.0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if conf.AccessKey != "prod-access-key" || conf.SecretKey != "prod-secret-key" {
+		t.Errorf("expected the credentials of the prod profile, got %s/%s", conf.AccessKey, conf.SecretKey)
+	}
+	if conf.SecurityToken != "prod-security-token" {
+		t.Errorf("expected SecurityToken to be prod-security-token, got %s", conf.SecurityToken)
+	}
+	if conf.Region != "ru-moscow-1" || conf.TenantName != "ru-moscow-1" {
+		t.Errorf("expected the region of the prod profile, got %s/%s", conf.Region, conf.TenantName)
+	}
+	if conf.DomainID != "prod-domain-id" {
+		t.Errorf("expected DomainID to be prod-domain-id, got %s", conf.DomainID)
+	}
+}
+
+func TestBuildConfig_profileCurrent(t *testing.T) {
+	raw := map[string]interface{}{
+		"region":             "ru-moscow-1",
+		"shared_config_file": testSharedConfigFile(t),
+	}
+	d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+
+	conf, err := buildConfig(d, "1.0.0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if conf.AccessKey != "dev-access-key" {
+		t.Errorf("expected the current profile to be used, got %s", conf.AccessKey)
+	}
+}
+
+func TestBuildConfig_profileStaticCredentials(t *testing.T) {
+	raw := map[string]interface{}{
+		"region":             "ru-moscow-1",
+		"access_key":         "access-key",
+		"secret_key":         "secret-key",
+		"shared_config_file": testSharedConfigFile(t),
+		"profile":            "prod",
+	}
+	d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+
+	conf, err := buildConfig(d, "1.0.0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if conf.AccessKey != "access-key" || conf.SecurityToken != "" {
+		t.Errorf("expected the static credentials to take precedence, got %s", conf.AccessKey)
+	}
+	if conf.DomainID != "prod-domain-id" {
+		t.Errorf("expected DomainID to be prod-domain-id, got %s", conf.DomainID)
+	}
+}
+
+func TestBuildConfig_profileNotFound(t *testing.T) {
+	raw := map[string]interface{}{
+		"region":             "ru-moscow-1",
+		"shared_config_file": testSharedConfigFile(t),
+		"profile":            "unknown",
+	}
+	d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+
+	if _, err := buildConfig(d, "1.0.0"); err == nil {
+		t.Fatal("expected an error for the unknown profile")
+	}
+}
+
+func TestBuildConfig_profileEnv(t *testing.T) {
+	t.Setenv("SBC_SHARED_CONFIG_FILE", testSharedConfigFile(t))
+	t.Setenv("SBC_PROFILE", "prod")
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{})
+	conf, err := buildConfig(d, "1.0.0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if conf.AccessKey != "prod-access-key" {
+		t.Errorf("expected the profile selected by SBC_PROFILE to be used, got %s", conf.AccessKey)
+	}
+}
+
+func TestBuildConfig_regionMissing(t *testing.T) {
+	t.Setenv("SBC_REGION_NAME", "")
+	t.Setenv("SBC_SHARED_CONFIG_FILE", "")
+	t.Setenv("SBC_PROFILE", "")
+
+	raw := map[string]interface{}{
+		"access_key": "access-key",
+		"secret_key": "secret-key",
+	}
+	d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+	_, err := buildConfig(d, "1.0.0")
+	if err == nil || !strings.Contains(err.Error(), "region must be set") {
+		t.Fatalf("expected an error for the missing region, got %v", err)
+	}
+}
+
+type testCertificate struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM string
+	keyPEM  string
+}
+
+// newTestCertificate issues a certificate signed by the parent, or a self-signed CA if parent is nil.
+func newTestCertificate(t *testing.T, parent *testCertificate, template *x509.Certificate) *testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Error generating the private key: %s", err)
+	}
+
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatalf("Error creating the certificate: %s", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Error parsing the certificate: %s", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Error marshaling the private key: %s", err)
+	}
+
+	return &testCertificate{
+		cert:    cert,
+		key:     key,
+		certPEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		keyPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	}
+}
+
+// testMutualTLSServer starts a stand-in which requires a client certificate issued by the test CA.
+func testMutualTLSServer(t *testing.T) (*httptest.Server, *testCertificate, *testCertificate) {
+	ca := newTestCertificate(t, nil, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	})
+	server := newTestCertificate(t, ca, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	client := newTestCertificate(t, ca, &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+
+	serverCert, err := tls.X509KeyPair([]byte(server.certPEM), []byte(server.keyPEM))
+	if err != nil {
+		t.Fatalf("Error loading the server certificate: %s", err)
+	}
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.cert)
+
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		fmt.Fprint(w, `<ListAllMyBucketsResult><Owner><ID>owner</ID></Owner><Buckets></Buckets></ListAllMyBucketsResult>`)
+	}))
+	ts.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	}
+	ts.StartTLS()
+	t.Cleanup(ts.Close)
+
+	return ts, ca, client
+}
+
+func TestBuildConfig_tls(t *testing.T) {
+	ts, ca, client := testMutualTLSServer(t)
+	endpoint := strings.Replace(ts.URL, "127.0.0.1", "localhost", 1) + "/"
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(ca.certPEM), 0600); err != nil {
+		t.Fatalf("Error writing the CA certificate: %s", err)
+	}
+
+	raw := map[string]interface{}{
+		"region":      "ru-moscow-1",
+		"access_key":  "access-key",
+		"secret_key":  "secret-key",
+		"domain_id":   "domain-id",
+		"max_retries": 0,
+		"cacert_file": caFile,
+		"cert":        client.certPEM,
+		"key":         client.keyPEM,
+	}
+	d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+
+	conf, err := buildConfig(d, "1.0.0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	// skip the project lookup, so that no request is sent to IAM
+	conf.TenantID = "project-id"
+	conf.Endpoints = map[string]string{"obs": endpoint}
+	if err := conf.LoadAndValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	resp, err := conf.HwClient.HTTPClient.Get(endpoint)
+	if err != nil {
+		t.Fatalf("Error requesting the stand-in with the golangsdk client: %s", err)
+	}
+	resp.Body.Close()
+
+	obsClient, err := conf.ObjectStorageClient("ru-moscow-1")
+	if err != nil {
+		t.Fatalf("Error creating the OBS client: %s", err)
+	}
+	if _, err := obsClient.ListBuckets(nil); err != nil {
+		t.Fatalf("Error requesting the stand-in with the OBS client: %s", err)
+	}
+
+	// the stand-in rejects the requests without the client certificate
+	delete(raw, "cert")
+	delete(raw, "key")
+	d = schema.TestResourceDataRaw(t, Provider().Schema, raw)
+	conf, err = buildConfig(d, "1.0.0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	conf.TenantID = "project-id"
+	if err := conf.LoadAndValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := conf.HwClient.HTTPClient.Get(endpoint); err == nil {
+		t.Fatal("expected the request without the client certificate to be rejected")
+	}
+}
+
+func TestBuildConfig_tlsInvalid(t *testing.T) {
+	_, ca, client := testMutualTLSServer(t)
+
+	cases := map[string]map[string]interface{}{
+		"invalid CA": {
+			"cacert_file": "not a certificate",
+		},
+		"missing CA file": {
+			"cacert_file": filepath.Join(t.TempDir(), "ca.pem"),
+		},
+		"mismatched key": {
+			"cacert_file": ca.certPEM,
+			"cert":        client.certPEM,
+			"key":         ca.keyPEM,
+		},
+	}
+
+	for name, raw := range cases {
+		raw["region"] = "ru-moscow-1"
+		d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+		if _, err := buildConfig(d, "1.0.0"); err == nil {
+			t.Errorf("[%s] expected an error", name)
+		}
+	}
+}
+
+func TestBuildConfig_tlsEnv(t *testing.T) {
+	_, ca, client := testMutualTLSServer(t)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(ca.certPEM), 0600); err != nil {
+		t.Fatalf("Error writing the CA certificate: %s", err)
+	}
+
+	cases := []struct {
+		env    string
+		value  string
+		actual func(*config.Config) string
+	}{
+		{"SBC_CACERT_FILE", caFile, func(c *config.Config) string { return c.CACertFile }},
+		{"SBC_CERT", client.certPEM, func(c *config.Config) string { return c.ClientCertFile }},
+		{"SBC_KEY", client.keyPEM, func(c *config.Config) string { return c.ClientKeyFile }},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.env, func(t *testing.T) {
+			t.Setenv("SBC_CACERT_FILE", "")
+			t.Setenv("SBC_CERT", "")
+			t.Setenv("SBC_KEY", "")
+			// the client certificate and key are only valid as a pair
+			if tc.env != "SBC_CACERT_FILE" {
+				t.Setenv("SBC_CERT", client.certPEM)
+				t.Setenv("SBC_KEY", client.keyPEM)
+			}
+			t.Setenv(tc.env, tc.value)
+
+			raw := map[string]interface{}{
+				"region": "ru-moscow-1",
+			}
+			d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+			conf, err := buildConfig(d, "1.0.0")
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			if v := tc.actual(conf); v != tc.value {
+				t.Errorf("expected %s to be used, got %q", tc.env, v)
+			}
+		})
+	}
+
+	t.Setenv("SBC_CACERT_FILE", "not a certificate")
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{"region": "ru-moscow-1"})
+	if _, err := buildConfig(d, "1.0.0"); err == nil {
+		t.Fatal("expected an error for the invalid certificate of SBC_CACERT_FILE")
+	}
+}
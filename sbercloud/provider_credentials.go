@@ -0,0 +1,115 @@
+package sbercloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+const (
+	// metadataSecurityKeyURL is the ECS metadata API which returns the temporary
+	// credentials of the agency attached to the instance.
+	metadataSecurityKeyURL = "http://169.254.169.254/openstack/latest/securitykey"
+
+	// metadataTimeout limits the time spent on the metadata API, it is not reachable
+	// outside the ECS instances and we don't want to hang in that case.
+	metadataTimeout = 10 * time.Second
+)
+
+type metadataSecurityKey struct {
+	Credential struct {
+		Access        string `json:"access"`
+		Secret        string `json:"secret"`
+		SecurityToken string `json:"securitytoken"`
+		ExpiresAt     string `json:"expires_at"`
+	} `json:"credential"`
+}
+
+func hasCredentials(conf *config.Config) bool {
+	return (conf.AccessKey != "" && conf.SecretKey != "") || conf.Password != ""
+}
+
+// applyEnvCredentials fills the credentials with the SBC_ACCESS_KEY, SBC_SECRET_KEY
+// and SBC_SECURITY_TOKEN environment variables if none was found yet, or with the
+// SBC_PASSWORD environment variable if the access key is not set.
+func applyEnvCredentials(conf *config.Config) {
+	if hasCredentials(conf) {
+		return
+	}
+
+	accessKey, _ := lookupEnv("access_key")
+	secretKey, _ := lookupEnv("secret_key")
+	if accessKey != "" && secretKey != "" {
+		securityToken, _ := lookupEnv("security_token")
+		conf.AccessKey, conf.SecretKey, conf.SecurityToken = accessKey, secretKey, securityToken
+		log.Printf("[DEBUG] using the credentials of the environment variables")
+		return
+	}
+
+	if password, _ := lookupEnv("password"); password != "" {
+		conf.Password = password
+		log.Printf("[DEBUG] using the password of the environment variables")
+	}
+}
+
+// applyMetadataCredentials is the last step of the credentials chain, it fetches the
+// temporary credentials from the ECS metadata API if none was found yet.
+// The expiration time is recorded so that the credentials are refreshed when the
+// service clients are created during long applies.
+func applyMetadataCredentials(conf *config.Config) error {
+	if hasCredentials(conf) {
+		return nil
+	}
+
+	key, err := getMetadataSecurityKey()
+	if err != nil {
+		return fmt.Errorf("no credentials found in the provider block, shared config file and environment "+
+			"variables, and failed to fetch them from the ECS metadata API: %s", err)
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, key.Credential.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("error parsing the expiration time of the metadata security key: %s", err)
+	}
+
+	conf.AccessKey = key.Credential.Access
+	conf.SecretKey = key.Credential.Secret
+	conf.SecurityToken = key.Credential.SecurityToken
+	conf.SecurityKeyExpiresAt = expiresAt
+	log.Printf("[DEBUG] using the metadata security key, which will expire at: %s", expiresAt)
+	return nil
+}
+
+func getMetadataSecurityKey() (*metadataSecurityKey, error) {
+	httpClient := &http.Client{Timeout: metadataTimeout}
+	resp, err := httpClient.Get(metadataSecurityKeyURL)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting metadata API: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error requesting metadata API: status code = %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading metadata API response: %s", err)
+	}
+
+	var key metadataSecurityKey
+	if err := json.Unmarshal(body, &key); err != nil {
+		return nil, fmt.Errorf("error parsing metadata API response: %s", err)
+	}
+
+	c := key.Credential
+	if c.Access == "" || c.Secret == "" || c.SecurityToken == "" || c.ExpiresAt == "" {
+		return nil, fmt.Errorf("the metadata API response does not contain the complete security key")
+	}
+	return &key, nil
+}
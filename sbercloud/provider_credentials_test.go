@@ -0,0 +1,227 @@
+package sbercloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// metadataRedirectTransport sends the requests of the ECS metadata API to the local stand-in.
+type metadataRedirectTransport struct {
+	target *url.URL
+	rt     http.RoundTripper
+}
+
+func (m *metadataRedirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host == "169.254.169.254" {
+		req = req.Clone(req.Context())
+		req.URL.Scheme = m.target.Scheme
+		req.URL.Host = m.target.Host
+	}
+	return m.rt.RoundTrip(req)
+}
+
+// testMetadataServer starts a local ECS metadata stand-in, each call returns a new
+// security key which expires after the given duration.
+func testMetadataServer(t *testing.T, expiresIn time.Duration) *int32 {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/openstack/latest/securitykey" {
+			http.NotFound(w, r)
+			return
+		}
+
+		n := atomic.AddInt32(&calls, 1)
+		expiresAt := time.Now().Add(expiresIn).UTC().Format(time.RFC3339)
+		fmt.Fprintf(w, `{"credential":{"access":"access-key-%[1]d","secret":"secret-key-%[1]d",`+
+			`"securitytoken":"security-token-%[1]d","expires_at":"%[2]s"}}`, n, expiresAt)
+	}))
+	t.Cleanup(server.Close)
+
+	target, _ := url.Parse(server.URL)
+	defaultTransport := http.DefaultTransport
+	http.DefaultTransport = &metadataRedirectTransport{target: target, rt: defaultTransport}
+	t.Cleanup(func() {
+		http.DefaultTransport = defaultTransport
+	})
+
+	return &calls
+}
+
+func TestBuildConfig_credentialsChain(t *testing.T) {
+	t.Setenv("SBC_ACCESS_KEY", "env-access-key")
+	t.Setenv("SBC_SECRET_KEY", "env-secret-key")
+	t.Setenv("SBC_SECURITY_TOKEN", "")
+	sharedConfigFile := testSharedConfigFile(t)
+
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected string
+	}{
+		{
+			name: "static",
+			raw: map[string]interface{}{
+				"access_key":         "access-key",
+				"secret_key":         "secret-key",
+				"shared_config_file": sharedConfigFile,
+				"profile":            "prod",
+			},
+			expected: "access-key",
+		},
+		{
+			name: "profile",
+			raw: map[string]interface{}{
+				"shared_config_file": sharedConfigFile,
+				"profile":            "prod",
+			},
+			expected: "prod-access-key",
+		},
+		{
+			name:     "env",
+			raw:      map[string]interface{}{},
+			expected: "env-access-key",
+		},
+	}
+
+	for _, tc := range cases {
+		tc.raw["region"] = "ru-moscow-1"
+		d := schema.TestResourceDataRaw(t, Provider().Schema, tc.raw)
+
+		conf, err := buildConfig(d, "1.0.0")
+		if err != nil {
+			t.Fatalf("[%s] err: %s", tc.name, err)
+		}
+		if conf.AccessKey != tc.expected {
+			t.Errorf("[%s] expected the access key to be %s, got %s", tc.name, tc.expected, conf.AccessKey)
+		}
+	}
+}
+
+func TestBuildConfig_envPassword(t *testing.T) {
+	t.Setenv("SBC_ACCESS_KEY", "")
+	t.Setenv("SBC_SECRET_KEY", "")
+	t.Setenv("SBC_PASSWORD", "env-password")
+	sharedConfigFile := testSharedConfigFile(t)
+
+	cases := []struct {
+		name              string
+		raw               map[string]interface{}
+		expectedAccessKey string
+		expectedPassword  string
+	}{
+		{
+			name:             "static",
+			raw:              map[string]interface{}{"password": "password"},
+			expectedPassword: "password",
+		},
+		{
+			name: "profile",
+			raw: map[string]interface{}{
+				"shared_config_file": sharedConfigFile,
+				"profile":            "prod",
+			},
+			expectedAccessKey: "prod-access-key",
+		},
+		{
+			name:             "env",
+			raw:              map[string]interface{}{},
+			expectedPassword: "env-password",
+		},
+	}
+
+	for _, tc := range cases {
+		tc.raw["region"] = "ru-moscow-1"
+		d := schema.TestResourceDataRaw(t, Provider().Schema, tc.raw)
+
+		conf, err := buildConfig(d, "1.0.0")
+		if err != nil {
+			t.Fatalf("[%s] err: %s", tc.name, err)
+		}
+		if conf.AccessKey != tc.expectedAccessKey || conf.Password != tc.expectedPassword {
+			t.Errorf("[%s] expected the access key %q and the password %q, got %q and %q", tc.name,
+				tc.expectedAccessKey, tc.expectedPassword, conf.AccessKey, conf.Password)
+		}
+	}
+}
+
+func TestApplyMetadataCredentials(t *testing.T) {
+	calls := testMetadataServer(t, time.Hour)
+
+	conf := &config.Config{AccessKey: "access-key", SecretKey: "secret-key"}
+	if err := applyMetadataCredentials(conf); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if *calls != 0 {
+		t.Fatalf("expected the metadata API not to be called when the credentials are provided")
+	}
+
+	conf = &config.Config{}
+	if err := applyMetadataCredentials(conf); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if conf.AccessKey != "access-key-1" || conf.SecretKey != "secret-key-1" || conf.SecurityToken != "security-token-1" {
+		t.Errorf("expected the metadata security key, got %s/%s/%s", conf.AccessKey, conf.SecretKey, conf.SecurityToken)
+	}
+	if conf.SecurityKeyExpiresAt.IsZero() {
+		t.Error("expected the expiration time of the metadata security key to be recorded")
+	}
+}
+
+func TestApplyMetadataCredentials_unavailable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	defaultTransport := http.DefaultTransport
+	http.DefaultTransport = &metadataRedirectTransport{target: target, rt: defaultTransport}
+	defer func() {
+		http.DefaultTransport = defaultTransport
+	}()
+
+	if err := applyMetadataCredentials(&config.Config{}); err == nil {
+		t.Fatal("expected an error when the metadata API is unavailable")
+	}
+}
+
+func TestMetadataCredentials_refresh(t *testing.T) {
+	// the security key is refreshed when it expires within 10 minutes
+	calls := testMetadataServer(t, 5*time.Minute)
+	t.Setenv("SBC_ACCESS_KEY", "")
+	t.Setenv("SBC_SECRET_KEY", "")
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"region":    "ru-moscow-1",
+		"domain_id": "domain-id",
+	})
+	conf, err := buildConfig(d, "1.0.0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	// skip the project lookup, so that no request is sent to IAM
+	conf.TenantID = "project-id"
+
+	if err := applyMetadataCredentials(conf); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := conf.LoadAndValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err := conf.NewServiceClient("vpc", "ru-moscow-1"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if conf.AccessKey != "access-key-2" || conf.SecurityToken != "security-token-2" {
+		t.Errorf("expected the expiring security key to be refreshed, got %s/%s", conf.AccessKey, conf.SecurityToken)
+	}
+	if *calls != 2 {
+		t.Errorf("expected the metadata API to be called twice, got %d", *calls)
+	}
+}
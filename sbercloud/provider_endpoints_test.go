@@ -0,0 +1,52 @@
+package sbercloud
+
+import (
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestServiceEndpoints checks that the service clients are built with the endpoints of
+// SberCloud, which are named <service>.<region>.hc.sbercloud.ru.
+func TestServiceEndpoints(t *testing.T) {
+	cases := map[string]string{
+		"aom":           "https://aom.ru-moscow-1.hc.sbercloud.ru/svcstg/icmgr/v1/project-id/",
+		"apigv2":        "https://apig.ru-moscow-1.hc.sbercloud.ru/v2/project-id/apigw/",
+		"cfw":           "https://cfw.ru-moscow-1.hc.sbercloud.ru/v1/project-id/",
+		"dc":            "https://dcaas.ru-moscow-1.hc.sbercloud.ru/v3/project-id/",
+		"dds":           "https://dds.ru-moscow-1.hc.sbercloud.ru/v3/project-id/",
+		"er":            "https://er.ru-moscow-1.hc.sbercloud.ru/v3/project-id/",
+		"gaussdb":       "https://gaussdb.ru-moscow-1.hc.sbercloud.ru/v3/project-id/",
+		"lts":           "https://lts.ru-moscow-1.hc.sbercloud.ru/v2/project-id/",
+		"opengauss":     "https://gaussdb-opengauss.ru-moscow-1.hc.sbercloud.ru/v3/project-id/",
+		"vpn":           "https://vpn.ru-moscow-1.hc.sbercloud.ru/v5/project-id/",
+		"vpcep":         "https://vpcep.ru-moscow-1.hc.sbercloud.ru/v1/project-id/",
+		"waf":           "https://waf.ru-moscow-1.hc.sbercloud.ru/v1/project-id/waf/",
+		"waf-dedicated": "https://waf.ru-moscow-1.hc.sbercloud.ru/v1/project-id/premium-waf/",
+	}
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"region":     "ru-moscow-1",
+		"access_key": "access-key",
+		"secret_key": "secret-key",
+	})
+	conf, err := buildConfig(d, "1.0.0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	// the project is known so that no request is sent to IAM
+	conf.HwClient = &golangsdk.ProviderClient{}
+	conf.RegionProjectIDMap["ru-moscow-1"] = "project-id"
+
+	for srv, expected := range cases {
+		client, err := conf.NewServiceClient(srv, "ru-moscow-1")
+		if err != nil {
+			t.Errorf("error creating the client of %s: %s", srv, err)
+			continue
+		}
+		if client.ResourceBase != expected {
+			t.Errorf("expected the client of %s to use %s, got %s", srv, expected, client.ResourceBase)
+		}
+	}
+}
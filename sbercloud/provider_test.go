@@ -20,6 +20,7 @@ var (
 	SBC_PROJECT_ID                 = os.Getenv("SBC_PROJECT_ID")
 	SBC_REGION_NAME                = os.Getenv("SBC_REGION_NAME")
 	SBC_SECRET_KEY                 = os.Getenv("SBC_SECRET_KEY")
+	SBC_TEST_MODE                  = os.Getenv("SBC_TEST_MODE")
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -32,7 +33,16 @@ func init() {
 	}
 }
 
+// testAccPreCheckTestMode skips the tests of this package in record and replay modes,
+// only the tests using the acceptance package are served by the API stand-in.
+func testAccPreCheckTestMode(t *testing.T) {
+	if SBC_TEST_MODE != "" {
+		t.Skip("SBC_TEST_MODE is only supported by the tests of the acceptance package")
+	}
+}
+
 func testAccPreCheckRequiredEnvVars(t *testing.T) {
+	testAccPreCheckTestMode(t)
 	if SBC_REGION_NAME == "" {
 		t.Fatal("SBC_REGION_NAME must be set for acceptance tests")
 	}
@@ -43,18 +53,21 @@ func testAccPreCheck(t *testing.T) {
 }
 
 func testAccPreCheckAdminOnly(t *testing.T) {
+	testAccPreCheckTestMode(t)
 	if SBC_ADMIN == "" {
 		t.Skip("SBC_ADMIN must be set for acceptance tests")
 	}
 }
 
 func testAccPreCheckEpsID(t *testing.T) {
+	testAccPreCheckTestMode(t)
 	if SBC_ENTERPRISE_PROJECT_ID_TEST == "" {
 		t.Skip("This environment does not support EPS_ID tests")
 	}
 }
 
 func testAccPreCheckOBS(t *testing.T) {
+	testAccPreCheckTestMode(t)
 	if SBC_ACCESS_KEY == "" || SBC_SECRET_KEY == "" {
 		t.Skip("SBC_ACCESS_KEY and SBC_SECRET_KEY must be set for OBS acceptance tests")
 	}
@@ -82,26 +82,26 @@ func ResourceComputeInstanceV2() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 				Computed:    true,
-				DefaultFunc: schema.EnvDefaultFunc("HW_IMAGE_ID", nil),
+				DefaultFunc: envDefaultFunc("compute_instance.image_id", nil),
 			},
 			"image_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				ForceNew:    true,
 				Computed:    true,
-				DefaultFunc: schema.EnvDefaultFunc("HW_IMAGE_NAME", nil),
+				DefaultFunc: envDefaultFunc("compute_instance.image_name", nil),
 			},
 			"flavor_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				DefaultFunc: schema.EnvDefaultFunc("HW_FLAVOR_ID", nil),
+				DefaultFunc: envDefaultFunc("compute_instance.flavor_id", nil),
 			},
 			"flavor_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				DefaultFunc: schema.EnvDefaultFunc("HW_FLAVOR_NAME", nil),
+				DefaultFunc: envDefaultFunc("compute_instance.flavor_name", nil),
 			},
 			"admin_pass": {
 				Type:      schema.TypeString,
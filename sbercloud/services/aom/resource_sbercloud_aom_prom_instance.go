@@ -0,0 +1,205 @@
+package aom
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const promInstanceHttpUrl = "v1/{project_id}/aom/prometheus"
+
+// ResourcePromInstance manages a Prometheus instance hosted by AOM, the metrics of the CCE clusters, the ECS
+// servers and the remote write clients are reported to the instance by its type.
+func ResourcePromInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePromInstanceCreate,
+		ReadContext:   resourcePromInstanceRead,
+		DeleteContext: resourcePromInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"prom_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"prom_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"default", "ECS", "VPC", "CCE", "REMOTE_WRITE", "KUBERNETES", "CLOUD_SERVICE", "ACROSS_ACCOUNT",
+				}, false),
+			},
+			"prom_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"remote_write_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remote_read_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"prom_http_api_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildPromInstanceHeaders(epsId string) map[string]string {
+	headers := map[string]string{"Content-Type": "application/json"}
+	if epsId != "" {
+		headers["Enterprise-Project-Id"] = epsId
+	}
+	return headers
+}
+
+func resourcePromInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("aom", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating AOM client: %s", err)
+	}
+
+	createPath := client.Endpoint + strings.ReplaceAll(promInstanceHttpUrl, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		MoreHeaders:      buildPromInstanceHeaders(cfg.GetEnterpriseProjectID(d)),
+		JSONBody: utils.RemoveNil(map[string]interface{}{
+			"prom_name":    d.Get("prom_name"),
+			"prom_type":    d.Get("prom_type"),
+			"prom_version": utils.ValueIngoreEmpty(d.Get("prom_version")),
+		}),
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating AOM prometheus instance: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := utils.PathSearch("prometheus[0].prom_id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the AOM prometheus instance ID in the API response")
+	}
+
+	d.SetId(id)
+	return resourcePromInstanceRead(ctx, d, meta)
+}
+
+// isPromInstanceDeleted checks the deletion time, the deleted instances are still listed for a while.
+func isPromInstanceDeleted(instance interface{}) bool {
+	deletedTime, _ := utils.PathSearch("deleted_time", instance, nil).(float64)
+	return deletedTime != 0
+}
+
+func resourcePromInstanceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("aom", region)
+	if err != nil {
+		return diag.Errorf("error creating AOM client: %s", err)
+	}
+
+	getPath := client.Endpoint + strings.ReplaceAll(promInstanceHttpUrl, "{project_id}", client.ProjectID)
+	getPath += fmt.Sprintf("?prom_id=%s", d.Id())
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		// the instances of all enterprise projects are queried, so that the imported instances are found
+		MoreHeaders: buildPromInstanceHeaders("all_granted_eps"),
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving AOM prometheus instance")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instance := utils.PathSearch(fmt.Sprintf("prometheus[?prom_id=='%s']|[0]", d.Id()), respBody, nil)
+	if instance == nil || isPromInstanceDeleted(instance) {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving AOM prometheus instance")
+	}
+
+	var createdAt string
+	if timestamp, ok := utils.PathSearch("prom_create_timestamp", instance, nil).(float64); ok {
+		createdAt = utils.FormatTimeStampRFC3339(int64(timestamp)/1000, false)
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("prom_name", utils.PathSearch("prom_name", instance, nil)),
+		d.Set("prom_type", utils.PathSearch("prom_type", instance, nil)),
+		d.Set("prom_version", utils.PathSearch("prom_version", instance, nil)),
+		d.Set("enterprise_project_id", utils.PathSearch("enterprise_project_id", instance, nil)),
+		d.Set("remote_write_url", utils.PathSearch("prom_spec_config.remote_write_url", instance, nil)),
+		d.Set("remote_read_url", utils.PathSearch("prom_spec_config.remote_read_url", instance, nil)),
+		d.Set("prom_http_api_endpoint", utils.PathSearch("prom_spec_config.prom_http_api_endpoint", instance, nil)),
+		d.Set("created_at", createdAt),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting AOM prometheus instance fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePromInstanceDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("aom", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating AOM client: %s", err)
+	}
+
+	deletePath := client.Endpoint + strings.ReplaceAll(promInstanceHttpUrl, "{project_id}", client.ProjectID)
+	deletePath += fmt.Sprintf("?prom_id=%s", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+		MoreHeaders:      buildPromInstanceHeaders(d.Get("enterprise_project_id").(string)),
+	}
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting AOM prometheus instance")
+	}
+
+	return nil
+}
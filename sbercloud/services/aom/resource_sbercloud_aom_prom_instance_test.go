@@ -0,0 +1,34 @@
+package aom
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsPromInstanceDeleted(t *testing.T) {
+	testCases := map[string]struct {
+		instance interface{}
+		expected bool
+	}{
+		"active":  {map[string]interface{}{"prom_id": "id", "deleted_time": float64(0)}, false},
+		"missing": {map[string]interface{}{"prom_id": "id"}, false},
+		"deleted": {map[string]interface{}{"prom_id": "id", "deleted_time": float64(1690000000000)}, true},
+	}
+	for name, tc := range testCases {
+		if got := isPromInstanceDeleted(tc.instance); got != tc.expected {
+			t.Errorf("%s: expected %v, got %v", name, tc.expected, got)
+		}
+	}
+}
+
+func TestBuildPromInstanceHeaders(t *testing.T) {
+	expected := map[string]string{"Content-Type": "application/json"}
+	if got := buildPromInstanceHeaders(""); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+
+	expected["Enterprise-Project-Id"] = "0"
+	if got := buildPromInstanceHeaders("0"); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,271 @@
+package apig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	appAuthHttpUrl       = "v2/{project_id}/apigw/instances/{instance_id}/app-auths"
+	appAuthQueryPageSize = 500
+)
+
+// ResourceApplicationAuthorization manages the APIs authorized to an application in the specified environment, only
+// the authorized applications are able to call the APIs with the APP authentication.
+func ResourceApplicationAuthorization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceApplicationAuthorizationCreate,
+		ReadContext:   resourceApplicationAuthorizationRead,
+		UpdateContext: resourceApplicationAuthorizationUpdate,
+		DeleteContext: resourceApplicationAuthorizationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceApplicationAuthorizationImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"api_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func buildAppAuthPath(client *golangsdk.ServiceClient, instanceId string) string {
+	path := strings.ReplaceAll(appAuthHttpUrl, "{project_id}", client.ProjectID)
+	return client.Endpoint + strings.ReplaceAll(path, "{instance_id}", instanceId)
+}
+
+func authorizeApis(client *golangsdk.ServiceClient, instanceId, envId, appId string, apiIds []interface{}) error {
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody: map[string]interface{}{
+			"env_id":  envId,
+			"app_ids": []string{appId},
+			"api_ids": apiIds,
+		},
+	}
+	_, err := client.Request("POST", buildAppAuthPath(client, instanceId), &createOpt)
+	return err
+}
+
+// listAppAuthorizations queries all authorization records of the application in the environment.
+func listAppAuthorizations(client *golangsdk.ServiceClient, instanceId, envId, appId string) ([]interface{}, error) {
+	listPath := buildAppAuthPath(client, instanceId) + "/binded-apis"
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+
+	result := make([]interface{}, 0)
+	for offset := 0; ; offset += appAuthQueryPageSize {
+		queryPath := listPath + fmt.Sprintf("?app_id=%s&env_id=%s&limit=%d&offset=%d", appId, envId,
+			appAuthQueryPageSize, offset)
+		resp, err := client.Request("GET", queryPath, &listOpt)
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		auths := utils.PathSearch("auths", respBody, make([]interface{}, 0)).([]interface{})
+		result = append(result, auths...)
+		if len(auths) < appAuthQueryPageSize {
+			break
+		}
+	}
+	return result, nil
+}
+
+func flattenAuthorizedApiIds(auths []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(auths))
+	for _, auth := range auths {
+		result = append(result, utils.PathSearch("api_id", auth, ""))
+	}
+	return result
+}
+
+// filterAppAuthorizationIds returns the IDs of the authorization records which belong to the specified APIs.
+func filterAppAuthorizationIds(auths []interface{}, apiIds *schema.Set) []string {
+	result := make([]string, 0)
+	for _, auth := range auths {
+		if apiIds.Contains(utils.PathSearch("api_id", auth, "")) {
+			result = append(result, utils.PathSearch("id", auth, "").(string))
+		}
+	}
+	return result
+}
+
+func unauthorizeApis(client *golangsdk.ServiceClient, instanceId string, authIds []string) error {
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+	}
+	for _, authId := range authIds {
+		deletePath := buildAppAuthPath(client, instanceId) + "/" + authId
+		if _, err := client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+			return fmt.Errorf("error deleting authorization record (%s): %s", authId, err)
+		}
+	}
+	return nil
+}
+
+func resourceApplicationAuthorizationCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ApigV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	var (
+		instanceId = d.Get("instance_id").(string)
+		envId      = d.Get("env_id").(string)
+		appId      = d.Get("application_id").(string)
+	)
+	if err = authorizeApis(client, instanceId, envId, appId, d.Get("api_ids").(*schema.Set).List()); err != nil {
+		return diag.Errorf("error authorizing APIs to the application (%s): %s", appId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", envId, appId))
+	return resourceApplicationAuthorizationRead(ctx, d, meta)
+}
+
+func resourceApplicationAuthorizationRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.ApigV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	auths, err := listAppAuthorizations(client, d.Get("instance_id").(string), d.Get("env_id").(string),
+		d.Get("application_id").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "application authorization")
+	}
+	if len(auths) < 1 {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "application authorization")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("api_ids", flattenAuthorizedApiIds(auths)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting application authorization fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceApplicationAuthorizationUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ApigV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	var (
+		instanceId = d.Get("instance_id").(string)
+		envId      = d.Get("env_id").(string)
+		appId      = d.Get("application_id").(string)
+
+		oldRaw, newRaw = d.GetChange("api_ids")
+		removeApiIds   = oldRaw.(*schema.Set).Difference(newRaw.(*schema.Set))
+		addApiIds      = newRaw.(*schema.Set).Difference(oldRaw.(*schema.Set))
+	)
+	if removeApiIds.Len() > 0 {
+		auths, err := listAppAuthorizations(client, instanceId, envId, appId)
+		if err != nil {
+			return diag.Errorf("error retrieving authorization records of the application (%s): %s", appId, err)
+		}
+		if err = unauthorizeApis(client, instanceId, filterAppAuthorizationIds(auths, removeApiIds)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if addApiIds.Len() > 0 {
+		if err = authorizeApis(client, instanceId, envId, appId, addApiIds.List()); err != nil {
+			return diag.Errorf("error authorizing APIs to the application (%s): %s", appId, err)
+		}
+	}
+
+	return resourceApplicationAuthorizationRead(ctx, d, meta)
+}
+
+func resourceApplicationAuthorizationDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ApigV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	var (
+		instanceId = d.Get("instance_id").(string)
+		appId      = d.Get("application_id").(string)
+	)
+	auths, err := listAppAuthorizations(client, instanceId, d.Get("env_id").(string), appId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving authorization records of the application")
+	}
+	authIds := filterAppAuthorizationIds(auths, d.Get("api_ids").(*schema.Set))
+	if err = unauthorizeApis(client, instanceId, authIds); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceApplicationAuthorizationImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <instance_id>/<env_id>/<application_id>")
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", parts[1], parts[2]))
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("env_id", parts[1]),
+		d.Set("application_id", parts[2]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,30 @@
+package apig
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var testAppAuthorizations = []interface{}{
+	map[string]interface{}{"id": "auth-1", "api_id": "api-1", "env_id": "env-id", "app_id": "app-id"},
+	map[string]interface{}{"id": "auth-2", "api_id": "api-2", "env_id": "env-id", "app_id": "app-id"},
+	map[string]interface{}{"id": "auth-3", "api_id": "api-3", "env_id": "env-id", "app_id": "app-id"},
+}
+
+func TestFlattenAuthorizedApiIds(t *testing.T) {
+	expected := []interface{}{"api-1", "api-2", "api-3"}
+	if got := flattenAuthorizedApiIds(testAppAuthorizations); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestFilterAppAuthorizationIds(t *testing.T) {
+	apiIds := schema.NewSet(schema.HashString, []interface{}{"api-1", "api-3", "api-4"})
+
+	expected := []string{"auth-1", "auth-3"}
+	if got := filterAppAuthorizationIds(testAppAuthorizations, apiIds); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
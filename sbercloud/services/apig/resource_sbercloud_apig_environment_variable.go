@@ -0,0 +1,138 @@
+package apig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk/openstack/apigw/dedicated/v2/environments"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// ResourceEnvironmentVariable manages a variable of the API group in the specified environment. The variables are
+// referenced by the API definitions as #name# and are replaced by the values when the APIs are published.
+func ResourceEnvironmentVariable() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEnvironmentVariableCreate,
+		ReadContext:   resourceEnvironmentVariableRead,
+		DeleteContext: resourceEnvironmentVariableDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEnvironmentVariableImportState,
+		},
+
+		// The API does not support updating the variables.
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceEnvironmentVariableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ApigV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	opts := environments.CreateVariableOpts{
+		GroupId: d.Get("group_id").(string),
+		EnvId:   d.Get("env_id").(string),
+		Name:    d.Get("name").(string),
+		Value:   d.Get("value").(string),
+	}
+	variable, err := environments.CreateVariable(client, instanceId, opts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating environment variable of the dedicated instance (%s): %s", instanceId, err)
+	}
+
+	d.SetId(variable.Id)
+	return resourceEnvironmentVariableRead(ctx, d, meta)
+}
+
+func resourceEnvironmentVariableRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.ApigV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	variable, err := environments.GetVariable(client, d.Get("instance_id").(string), d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "environment variable")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("group_id", variable.GroupId),
+		d.Set("env_id", variable.EnvId),
+		d.Set("name", variable.Name),
+		d.Set("value", variable.Value),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting environment variable fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentVariableDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ApigV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	if err = environments.DeleteVariable(client, d.Get("instance_id").(string), d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting environment variable")
+	}
+
+	return nil
+}
+
+func resourceEnvironmentVariableImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <instance_id>/<id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
@@ -0,0 +1,102 @@
+package cce
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/addons"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/nodes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// autoscalerAddonName is the name of the add-on template of the Cluster Autoscaler.
+const autoscalerAddonName = "autoscaler"
+
+// taskStatusRefreshFunc queries the phase of the asynchronous task by the path, such as the pre-check and the upgrade
+// tasks of the clusters.
+func taskStatusRefreshFunc(client *golangsdk.ServiceClient, taskPath string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes:          []int{200},
+			MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+		}
+		resp, err := client.Request("GET", taskPath, &getOpt)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+
+		phase := utils.PathSearch("status.phase", respBody, "").(string)
+		switch phase {
+		case "Success":
+			return respBody, "COMPLETED", nil
+		case "Failed", "Error":
+			return respBody, "ERROR", fmt.Errorf("the task failed: %v", utils.PathSearch("status.message", respBody, ""))
+		}
+		return respBody, "PENDING", nil
+	}
+}
+
+func waitForTaskCompleted(ctx context.Context, client *golangsdk.ServiceClient, taskPath string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      taskStatusRefreshFunc(client, taskPath),
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// waitForJobCompleted waits for the CCE job, the operations of the nodes are executed by the jobs.
+func waitForJobCompleted(ctx context.Context, client *golangsdk.ServiceClient, jobId string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			job, err := nodes.GetJobDetails(client, jobId).ExtractJob()
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			switch job.Status.Phase {
+			case "Success":
+				return job, "COMPLETED", nil
+			case "Failed":
+				return job, "ERROR", fmt.Errorf("the job (%s) failed: %s", jobId, job.Status.Reason)
+			}
+			return job, "PENDING", nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// checkAutoscalerInstalled makes sure the autoscaler add-on is installed in the cluster, the autoscaling of the node
+// pools and the autoscaling policies take no effect without it.
+func checkAutoscalerInstalled(addonClient *golangsdk.ServiceClient, clusterId string) error {
+	installed, err := addons.List(addonClient, clusterId, addons.ListOpts{AddonTemplateName: autoscalerAddonName})
+	if err != nil {
+		return fmt.Errorf("error retrieving the add-ons of the CCE cluster (%s): %s", clusterId, err)
+	}
+	if len(installed) == 0 {
+		return fmt.Errorf("the %s add-on is not installed in the CCE cluster (%s), please install it before "+
+			"enabling the autoscaling, the sbercloud_cce_addon resource of the add-on can be added to the "+
+			"depends_on of the node pool when they are created in the same apply", autoscalerAddonName, clusterId)
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package cce
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	// The names of the clusters in the kubeconfig returned by CCE.
+	certInternalCluster          = "internalCluster"
+	certExternalCluster          = "externalCluster"
+	certExternalTLSVerifyCluster = "externalClusterTLSVerify"
+
+	// The short-lived certificates are valid for one day, it is the minimum duration of the certificates.
+	shortLivedCertDuration = 1
+)
+
+// DataSourceClusterCertificate generates the certificate of the CCE cluster and returns the kubeconfig and the
+// credentials which the kubernetes and the helm providers are able to be configured with directly.
+func DataSourceClusterCertificate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClusterCertificateRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"duration": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Default:       30,
+				ConflictsWith: []string{"short_lived"},
+				ValidateFunc: validation.Any(
+					validation.IntBetween(1, 1825),
+					validation.IntInSlice([]int{-1}),
+				),
+				Description: `The validity period of the certificate, in days.`,
+			},
+			"short_lived": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Whether to generate the certificate which is only valid for one day.`,
+			},
+			"kube_config_raw": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: `The raw kubeconfig of the cluster.`,
+			},
+			"internal_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The endpoint of the cluster in the VPC.`,
+			},
+			"external_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The endpoint of the cluster through the EIP.`,
+			},
+			"cluster_ca_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The PEM-encoded root certificate of the cluster.`,
+			},
+			"client_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: `The PEM-encoded client certificate.`,
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: `The PEM-encoded private key of the client certificate.`,
+			},
+		},
+	}
+}
+
+func dataSourceClusterCertificateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CceV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	duration := d.Get("duration").(int)
+	if d.Get("short_lived").(bool) {
+		duration = shortLivedCertDuration
+	}
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+		JSONBody: map[string]interface{}{
+			"duration": duration,
+		},
+	}
+	resp, err := client.Request("POST", buildClusterOperationPath(client, clusterId, "clustercert"), &createOpt)
+	if err != nil {
+		return diag.Errorf("error generating the certificate of the CCE cluster (%s): %s", clusterId, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	kubeConfigRaw, err := utils.JsonMarshal(respBody)
+	if err != nil {
+		return diag.Errorf("error marshaling the kubeconfig of the CCE cluster (%s): %s", clusterId, err)
+	}
+	certificate, err := flattenClusterCertificate(respBody)
+	if err != nil {
+		return diag.Errorf("error parsing the certificate of the CCE cluster (%s): %s", clusterId, err)
+	}
+
+	d.SetId(clusterId)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("kube_config_raw", strings.TrimSpace(string(kubeConfigRaw))),
+		d.Set("internal_endpoint", certificate["internal_endpoint"]),
+		d.Set("external_endpoint", certificate["external_endpoint"]),
+		d.Set("cluster_ca_certificate", certificate["cluster_ca_certificate"]),
+		d.Set("client_certificate", certificate["client_certificate"]),
+		d.Set("client_key", certificate["client_key"]),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CCE cluster certificate fields: %s", err)
+	}
+	return nil
+}
+
+// flattenClusterCertificate parses the kubeconfig, the endpoints are picked by the cluster names and the base64
+// encoded certificates are decoded to the PEM format.
+func flattenClusterCertificate(kubeConfig interface{}) (map[string]interface{}, error) {
+	clusterPath := "clusters[?name=='%s']|[0].cluster.%s"
+	encodedCerts := map[string]string{
+		"cluster_ca_certificate": fmt.Sprintf(clusterPath, certInternalCluster, "\"certificate-authority-data\""),
+		"client_certificate":     "users[0].user.\"client-certificate-data\"",
+		"client_key":             "users[0].user.\"client-key-data\"",
+	}
+
+	searchServer := func(clusterName string) interface{} {
+		return utils.PathSearch(fmt.Sprintf(clusterPath, clusterName, "server"), kubeConfig, "")
+	}
+
+	// The external cluster which verifies the server certificate is preferred, it is absent in the old clusters.
+	externalEndpoint := searchServer(certExternalTLSVerifyCluster)
+	if externalEndpoint == "" {
+		externalEndpoint = searchServer(certExternalCluster)
+	}
+	result := map[string]interface{}{
+		"internal_endpoint": searchServer(certInternalCluster),
+		"external_endpoint": externalEndpoint,
+	}
+	for key, expression := range encodedCerts {
+		encoded, _ := utils.PathSearch(expression, kubeConfig, "").(string)
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode %s: %s", key, err)
+		}
+		result[key] = string(decoded)
+	}
+	return result, nil
+}
@@ -0,0 +1,99 @@
+package cce
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+)
+
+func TestFlattenClusterCertificate(t *testing.T) {
+	encode := func(s string) string {
+		return base64.StdEncoding.EncodeToString([]byte(s))
+	}
+	buildKubeConfig := func(clusterNames ...string) map[string]interface{} {
+		servers := map[string]string{
+			"internalCluster":          "https://192.168.0.10:5443",
+			"externalCluster":          "https://10.10.10.10:5443",
+			"externalClusterTLSVerify": "https://10.10.10.10:5443/tls",
+		}
+		clusters := make([]interface{}, 0, len(clusterNames))
+		for _, name := range clusterNames {
+			cluster := map[string]interface{}{"server": servers[name]}
+			if name != "externalCluster" {
+				cluster["certificate-authority-data"] = encode("CA of " + name)
+			}
+			clusters = append(clusters, map[string]interface{}{"name": name, "cluster": cluster})
+		}
+		return map[string]interface{}{
+			"kind":     "Config",
+			"clusters": clusters,
+			"users": []interface{}{
+				map[string]interface{}{
+					"name": "user",
+					"user": map[string]interface{}{
+						"client-certificate-data": encode("client certificate"),
+						"client-key-data":         encode("client key"),
+					},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		kubeConfig map[string]interface{}
+		expected   map[string]interface{}
+	}{
+		{
+			name:       "external cluster with TLS verification",
+			kubeConfig: buildKubeConfig("internalCluster", "externalCluster", "externalClusterTLSVerify"),
+			expected: map[string]interface{}{
+				"internal_endpoint":      "https://192.168.0.10:5443",
+				"external_endpoint":      "https://10.10.10.10:5443/tls",
+				"cluster_ca_certificate": "CA of internalCluster",
+				"client_certificate":     "client certificate",
+				"client_key":             "client key",
+			},
+		},
+		{
+			name:       "external cluster without TLS verification",
+			kubeConfig: buildKubeConfig("externalCluster", "internalCluster"),
+			expected: map[string]interface{}{
+				"internal_endpoint":      "https://192.168.0.10:5443",
+				"external_endpoint":      "https://10.10.10.10:5443",
+				"cluster_ca_certificate": "CA of internalCluster",
+				"client_certificate":     "client certificate",
+				"client_key":             "client key",
+			},
+		},
+		{
+			name:       "without EIP",
+			kubeConfig: buildKubeConfig("internalCluster"),
+			expected: map[string]interface{}{
+				"internal_endpoint":      "https://192.168.0.10:5443",
+				"external_endpoint":      "",
+				"cluster_ca_certificate": "CA of internalCluster",
+				"client_certificate":     "client certificate",
+				"client_key":             "client key",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		result, err := flattenClusterCertificate(tc.kubeConfig)
+		if err != nil {
+			t.Fatalf("[%s] unexpected error: %s", tc.name, err)
+		}
+		if !reflect.DeepEqual(result, tc.expected) {
+			t.Errorf("[%s] expected %v, got %v", tc.name, tc.expected, result)
+		}
+	}
+
+	invalid := buildKubeConfig("internalCluster")
+	invalid["users"] = []interface{}{
+		map[string]interface{}{"user": map[string]interface{}{"client-key-data": "invalid base64!"}},
+	}
+	if _, err := flattenClusterCertificate(invalid); err == nil {
+		t.Errorf("expected the error of decoding the invalid certificate, got nil")
+	}
+}
@@ -0,0 +1,418 @@
+package cce
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	// The autoscaling policies are the HorizontalNodeAutoscaler objects which are handled by the autoscaler add-on.
+	autoscalingPolicyApiVersion = "autoscaling.cce.io/v1alpha1"
+	autoscalingPolicyKind       = "HorizontalNodeAutoscaler"
+	autoscalingPolicyHttpUrl    = "apis/autoscaling.cce.io/v1alpha1/namespaces/kube-system/horizontalnodeautoscalers"
+)
+
+// The name of the policy is the name of the kubernetes object, it must be a DNS subdomain.
+var autoscalingPolicyNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]{0,251}[a-z0-9])?$`)
+
+// ResourceAutoscalingPolicy manages the autoscaling policy of the node pools, the node pools are scaled by the
+// autoscaler add-on according to the metric and the periodic rules of the policy.
+func ResourceAutoscalingPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAutoscalingPolicyCreate,
+		ReadContext:   resourceAutoscalingPolicyRead,
+		UpdateContext: resourceAutoscalingPolicyUpdate,
+		DeleteContext: resourceAutoscalingPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAutoscalingPolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					autoscalingPolicyNameRegexp,
+					"the name must consist of lower case alphanumeric characters, '-' or '.', and must start and "+
+						"end with an alphanumeric character",
+				),
+			},
+			"nodepool_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Metric", "Cron"}, false),
+						},
+						"action": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"ScaleUp", "ScaleDown"}, false),
+									},
+									"value": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"unit": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "Node",
+										ValidateFunc: validation.StringInSlice([]string{"Node", "Percent"}, false),
+									},
+								},
+							},
+						},
+						"metric_trigger": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"Cpu", "Memory"}, false),
+									},
+									"operator": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{">", "<"}, false),
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"cron_trigger": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"schedule": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+// buildAutoscalingPolicyPath builds the path of the policies, the objects of the cluster are accessed through the
+// endpoint of CCE which is prefixed with the cluster ID.
+func buildAutoscalingPolicyPath(client *golangsdk.ServiceClient, clusterId string, parts ...string) (string, error) {
+	endpoint, err := url.Parse(client.Endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid CCE endpoint (%s): %s", client.Endpoint, err)
+	}
+	endpoint.Host = fmt.Sprintf("%s.%s", clusterId, endpoint.Host)
+	endpoint.Path = "/"
+	return endpoint.String() + strings.Join(append([]string{autoscalingPolicyHttpUrl}, parts...), "/"), nil
+}
+
+func buildAutoscalingPolicyRules(rawRules []interface{}) ([]map[string]interface{}, error) {
+	rules := make([]map[string]interface{}, 0, len(rawRules))
+	for _, v := range rawRules {
+		raw, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rule := map[string]interface{}{
+			"ruleName": raw["name"],
+			"type":     raw["type"],
+			"disable":  !raw["enabled"].(bool),
+		}
+		if action, ok := getFirstMap(raw["action"]); ok {
+			rule["action"] = map[string]interface{}{
+				"type":  action["type"],
+				"unit":  action["unit"],
+				"value": action["value"],
+			}
+		}
+
+		metricTrigger, hasMetricTrigger := getFirstMap(raw["metric_trigger"])
+		cronTrigger, hasCronTrigger := getFirstMap(raw["cron_trigger"])
+		switch raw["type"] {
+		case "Metric":
+			if !hasMetricTrigger {
+				return nil, fmt.Errorf("metric_trigger is required by the Metric rule (%s)", raw["name"])
+			}
+			rule["metricTrigger"] = map[string]interface{}{
+				"metricName":      metricTrigger["metric_name"],
+				"metricOperation": metricTrigger["operator"],
+				"metricValue":     metricTrigger["value"],
+				"unit":            "Percent",
+			}
+		case "Cron":
+			if !hasCronTrigger {
+				return nil, fmt.Errorf("cron_trigger is required by the Cron rule (%s)", raw["name"])
+			}
+			rule["cronTrigger"] = map[string]interface{}{
+				"schedule": cronTrigger["schedule"],
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func getFirstMap(rawArray interface{}) (map[string]interface{}, bool) {
+	array, _ := rawArray.([]interface{})
+	if len(array) == 0 {
+		return nil, false
+	}
+	result, ok := array[0].(map[string]interface{})
+	return result, ok
+}
+
+func buildAutoscalingPolicySpec(d *schema.ResourceData) (map[string]interface{}, error) {
+	rules, err := buildAutoscalingPolicyRules(d.Get("rules").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"disable":           !d.Get("enabled").(bool),
+		"targetNodepoolIds": utils.ExpandToStringListBySet(d.Get("nodepool_ids").(*schema.Set)),
+		"rules":             rules,
+	}, nil
+}
+
+func resourceAutoscalingPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceAddonV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE add-on client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	if err = checkAutoscalerInstalled(client, clusterId); err != nil {
+		return diag.FromErr(err)
+	}
+	spec, err := buildAutoscalingPolicySpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	createPath, err := buildAutoscalingPolicyPath(client, clusterId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody: map[string]interface{}{
+			"apiVersion": autoscalingPolicyApiVersion,
+			"kind":       autoscalingPolicyKind,
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": "kube-system",
+			},
+			"spec": spec,
+		},
+	}
+	if _, err = client.Request("POST", createPath, &createOpt); err != nil {
+		return diag.Errorf("error creating CCE autoscaling policy: %s", err)
+	}
+
+	d.SetId(name)
+	return resourceAutoscalingPolicyRead(ctx, d, meta)
+}
+
+func flattenAutoscalingPolicyRules(rules interface{}) []map[string]interface{} {
+	rawArray, _ := rules.([]interface{})
+	result := make([]map[string]interface{}, 0, len(rawArray))
+	for _, v := range rawArray {
+		rule := map[string]interface{}{
+			"name":    utils.PathSearch("ruleName", v, nil),
+			"type":    utils.PathSearch("type", v, nil),
+			"enabled": !utils.PathSearch("disable", v, false).(bool),
+			"action": []map[string]interface{}{
+				{
+					"type":  utils.PathSearch("action.type", v, nil),
+					"unit":  utils.PathSearch("action.unit", v, nil),
+					"value": utils.PathSearch("action.value", v, nil),
+				},
+			},
+		}
+		if metricTrigger := utils.PathSearch("metricTrigger", v, nil); metricTrigger != nil {
+			rule["metric_trigger"] = []map[string]interface{}{
+				{
+					"metric_name": utils.PathSearch("metricName", metricTrigger, nil),
+					"operator":    utils.PathSearch("metricOperation", metricTrigger, nil),
+					"value":       utils.PathSearch("metricValue", metricTrigger, nil),
+				},
+			}
+		}
+		if cronTrigger := utils.PathSearch("cronTrigger", v, nil); cronTrigger != nil {
+			rule["cron_trigger"] = []map[string]interface{}{
+				{
+					"schedule": utils.PathSearch("schedule", cronTrigger, nil),
+				},
+			}
+		}
+		result = append(result, rule)
+	}
+	return result
+}
+
+func resourceAutoscalingPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CceAddonV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE add-on client: %s", err)
+	}
+
+	getPath, err := buildAutoscalingPolicyPath(client, d.Get("cluster_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CCE autoscaling policy")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("metadata.name", respBody, nil)),
+		d.Set("nodepool_ids", utils.PathSearch("spec.targetNodepoolIds", respBody, nil)),
+		d.Set("rules", flattenAutoscalingPolicyRules(utils.PathSearch("spec.rules", respBody, nil))),
+		d.Set("enabled", !utils.PathSearch("spec.disable", respBody, false).(bool)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CCE autoscaling policy fields: %s", err)
+	}
+	return nil
+}
+
+func resourceAutoscalingPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceAddonV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE add-on client: %s", err)
+	}
+
+	spec, err := buildAutoscalingPolicySpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	updatePath, err := buildAutoscalingPolicyPath(client, d.Get("cluster_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// The spec is replaced by the merge patch, the lists, such as the rules, are not merged element by element.
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		MoreHeaders:      map[string]string{"Content-Type": "application/merge-patch+json"},
+		JSONBody: map[string]interface{}{
+			"spec": spec,
+		},
+	}
+	if _, err = client.Request("PATCH", updatePath, &updateOpt); err != nil {
+		return diag.Errorf("error updating CCE autoscaling policy (%s): %s", d.Id(), err)
+	}
+	return resourceAutoscalingPolicyRead(ctx, d, meta)
+}
+
+func resourceAutoscalingPolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceAddonV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE add-on client: %s", err)
+	}
+
+	deletePath, err := buildAutoscalingPolicyPath(client, d.Get("cluster_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202},
+	}
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CCE autoscaling policy")
+	}
+	return nil
+}
+
+func resourceAutoscalingPolicyImport(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <cluster_id>/<name>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("cluster_id", parts[0])
+}
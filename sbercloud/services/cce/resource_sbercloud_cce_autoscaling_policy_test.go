@@ -0,0 +1,140 @@
+package cce
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+)
+
+func TestBuildAutoscalingPolicyPath(t *testing.T) {
+	client := &golangsdk.ServiceClient{
+		Endpoint:     "https://cce.ru-moscow-1.hc.sbercloud.ru/",
+		ResourceBase: "https://cce.ru-moscow-1.hc.sbercloud.ru/api/v3/",
+	}
+	expected := "https://cluster-1.cce.ru-moscow-1.hc.sbercloud.ru/" + autoscalingPolicyHttpUrl + "/policy-1"
+
+	result, err := buildAutoscalingPolicyPath(client, "cluster-1", "policy-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result != expected {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestBuildAutoscalingPolicyRules(t *testing.T) {
+	rawRules := []interface{}{
+		map[string]interface{}{
+			"name":    "cpu-scale-up",
+			"type":    "Metric",
+			"enabled": true,
+			"action": []interface{}{
+				map[string]interface{}{"type": "ScaleUp", "unit": "Node", "value": 2},
+			},
+			"metric_trigger": []interface{}{
+				map[string]interface{}{"metric_name": "Cpu", "operator": ">", "value": "80"},
+			},
+			"cron_trigger": []interface{}{},
+		},
+		map[string]interface{}{
+			"name":    "night-scale-down",
+			"type":    "Cron",
+			"enabled": false,
+			"action": []interface{}{
+				map[string]interface{}{"type": "ScaleDown", "unit": "Percent", "value": 50},
+			},
+			"metric_trigger": []interface{}{},
+			"cron_trigger": []interface{}{
+				map[string]interface{}{"schedule": "0 22 * * *"},
+			},
+		},
+	}
+	expected := []map[string]interface{}{
+		{
+			"ruleName": "cpu-scale-up",
+			"type":     "Metric",
+			"disable":  false,
+			"action":   map[string]interface{}{"type": "ScaleUp", "unit": "Node", "value": 2},
+			"metricTrigger": map[string]interface{}{
+				"metricName":      "Cpu",
+				"metricOperation": ">",
+				"metricValue":     "80",
+				"unit":            "Percent",
+			},
+		},
+		{
+			"ruleName":    "night-scale-down",
+			"type":        "Cron",
+			"disable":     true,
+			"action":      map[string]interface{}{"type": "ScaleDown", "unit": "Percent", "value": 50},
+			"cronTrigger": map[string]interface{}{"schedule": "0 22 * * *"},
+		},
+	}
+
+	result, err := buildAutoscalingPolicyRules(rawRules)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	invalid := []interface{}{
+		map[string]interface{}{"name": "cron", "type": "Cron", "enabled": true, "cron_trigger": []interface{}{}},
+	}
+	if _, err = buildAutoscalingPolicyRules(invalid); err == nil {
+		t.Errorf("expected the error of the missing cron_trigger, got nil")
+	}
+}
+
+func TestFlattenAutoscalingPolicyRules(t *testing.T) {
+	rules := []interface{}{
+		map[string]interface{}{
+			"ruleName": "memory-scale-up",
+			"type":     "Metric",
+			"action":   map[string]interface{}{"type": "ScaleUp", "unit": "Node", "value": float64(1)},
+			"metricTrigger": map[string]interface{}{
+				"metricName":      "Memory",
+				"metricOperation": ">",
+				"metricValue":     "70",
+				"unit":            "Percent",
+			},
+		},
+		map[string]interface{}{
+			"ruleName":    "morning-scale-up",
+			"type":        "Cron",
+			"disable":     true,
+			"action":      map[string]interface{}{"type": "ScaleUp", "unit": "Percent", "value": float64(20)},
+			"cronTrigger": map[string]interface{}{"schedule": "0 8 * * *"},
+		},
+	}
+	expected := []map[string]interface{}{
+		{
+			"name":    "memory-scale-up",
+			"type":    "Metric",
+			"enabled": true,
+			"action": []map[string]interface{}{
+				{"type": "ScaleUp", "unit": "Node", "value": float64(1)},
+			},
+			"metric_trigger": []map[string]interface{}{
+				{"metric_name": "Memory", "operator": ">", "value": "70"},
+			},
+		},
+		{
+			"name":    "morning-scale-up",
+			"type":    "Cron",
+			"enabled": false,
+			"action": []map[string]interface{}{
+				{"type": "ScaleUp", "unit": "Percent", "value": float64(20)},
+			},
+			"cron_trigger": []map[string]interface{}{
+				{"schedule": "0 8 * * *"},
+			},
+		},
+	}
+
+	if result := flattenAutoscalingPolicyRules(rules); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
@@ -0,0 +1,369 @@
+package cce
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/addons"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/clusters"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/templates"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/cce"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceCluster extends the cluster resource of huaweicloud with the in-place upgrades of the cluster version.
+// The upgrade is pre-checked and the installed add-ons which do not support the target version are upgraded
+// together with the cluster.
+func ResourceCluster() *schema.Resource {
+	resource := cce.ResourceCluster()
+	resource.Schema["cluster_version"].ForceNew = false
+
+	clusterRead, clusterUpdate := resource.ReadContext, resource.UpdateContext
+	resource.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return resourceClusterUpdate(ctx, d, meta, clusterRead, clusterUpdate)
+	}
+	return resource
+}
+
+func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{},
+	clusterRead schema.ReadContextFunc, clusterUpdate schema.UpdateContextFunc) diag.Diagnostics {
+	if !d.HasChange("cluster_version") {
+		return clusterUpdate(ctx, d, meta)
+	}
+
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+	addonClient, err := cfg.CceAddonV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE add-on v3 client: %s", err)
+	}
+
+	// The values are saved before the other changes are applied, the refresh of the state overwrites them.
+	// All the phases of the upgrade and the other changes share the update timeout.
+	oldVersion, newVersion := d.GetChange("cluster_version")
+	upgradeOpts := clusterUpgradeOpts{
+		clusterId:      d.Id(),
+		clusterType:    d.Get("cluster_type").(string),
+		currentVersion: oldVersion.(string),
+		targetVersion:  newVersion.(string),
+		deadline:       time.Now().Add(d.Timeout(schema.TimeoutUpdate)),
+	}
+
+	// The hibernated cluster can not be upgraded, the cluster which is going to be hibernated is upgraded before the
+	// other changes are applied, and the cluster which is going to be awakened is upgraded after that.
+	oldHibernate, newHibernate := d.GetChange("hibernate")
+	if oldHibernate.(bool) && newHibernate.(bool) {
+		return diag.Errorf("the CCE cluster (%s) is hibernating, it must be awakened before the upgrade", d.Id())
+	}
+	if newHibernate.(bool) {
+		if err = upgradeCluster(ctx, client, addonClient, upgradeOpts); err != nil {
+			return diag.FromErr(err)
+		}
+		return clusterUpdate(ctx, d, meta)
+	}
+
+	if diags := clusterUpdate(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+	if err = upgradeCluster(ctx, client, addonClient, upgradeOpts); err != nil {
+		return diag.FromErr(err)
+	}
+	return clusterRead(ctx, d, meta)
+}
+
+type clusterUpgradeOpts struct {
+	clusterId      string
+	clusterType    string
+	currentVersion string
+	targetVersion  string
+	deadline       time.Time
+}
+
+// remainingTimeout returns the timeout of the next phase of the upgrade, which is the time left before the deadline.
+func (opts clusterUpgradeOpts) remainingTimeout() (time.Duration, error) {
+	remaining := time.Until(opts.deadline)
+	if remaining <= 0 {
+		return 0, fmt.Errorf("timeout while upgrading the CCE cluster (%s)", opts.clusterId)
+	}
+	return remaining, nil
+}
+
+func buildClusterOperationPath(client *golangsdk.ServiceClient, clusterId string, parts ...string) string {
+	return client.ServiceURL(append([]string{"clusters", clusterId}, parts...)...)
+}
+
+// matchClusterTargetVersion returns the upgradable version which is the specified version or a patch of it.
+func matchClusterTargetVersion(targetVersions []interface{}, version string) (string, bool) {
+	for _, v := range targetVersions {
+		if target, ok := v.(string); ok && target == version {
+			return target, true
+		}
+	}
+	for _, v := range targetVersions {
+		if target, ok := v.(string); ok && (strings.HasPrefix(target, version+".") ||
+			strings.HasPrefix(target, version+"-")) {
+			return target, true
+		}
+	}
+	return "", false
+}
+
+func getClusterTargetVersion(client *golangsdk.ServiceClient, clusterId, version string) (string, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	resp, err := client.Request("GET", buildClusterOperationPath(client, clusterId, "upgradeinfo"), &getOpt)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving the upgrade information of the CCE cluster (%s): %s", clusterId, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return "", err
+	}
+
+	targetVersions := utils.PathSearch("spec.versionInfo.targetVersions", respBody, make([]interface{}, 0)).([]interface{})
+	target, ok := matchClusterTargetVersion(targetVersions, version)
+	if !ok {
+		return "", fmt.Errorf("the CCE cluster (%s) can not be upgraded to %s, the available versions are: %v",
+			clusterId, version, targetVersions)
+	}
+	return target, nil
+}
+
+func preCheckClusterUpgrade(ctx context.Context, client *golangsdk.ServiceClient, opts clusterUpgradeOpts,
+	targetVersion string) error {
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+		JSONBody: map[string]interface{}{
+			"apiVersion": "v3",
+			"kind":       "PreCheckTask",
+			"spec": map[string]interface{}{
+				"clusterVersion": opts.currentVersion,
+				"targetVersion":  targetVersion,
+			},
+		},
+	}
+	resp, err := client.Request("POST", buildClusterOperationPath(client, opts.clusterId, "operation", "precheck"),
+		&createOpt)
+	if err != nil {
+		return fmt.Errorf("error pre-checking the upgrade of the CCE cluster (%s): %s", opts.clusterId, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	taskId := utils.PathSearch("metadata.uid", respBody, "").(string)
+	if taskId == "" {
+		return fmt.Errorf("unable to find the pre-check task ID of the CCE cluster (%s)", opts.clusterId)
+	}
+	taskPath := buildClusterOperationPath(client, opts.clusterId, "operation", "precheck", "tasks", taskId)
+	timeout, err := opts.remainingTimeout()
+	if err != nil {
+		return err
+	}
+	if err = waitForTaskCompleted(ctx, client, taskPath, timeout); err != nil {
+		return fmt.Errorf("the upgrade pre-check of the CCE cluster (%s) is not passed: %s", opts.clusterId, err)
+	}
+	return nil
+}
+
+// compareAddonVersions compares the dot separated versions of the add-ons, such as 1.25.1.
+func compareAddonVersions(a, b string) int {
+	partsA, partsB := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		numA, errA := strconv.Atoi(partsA[i])
+		numB, errB := strconv.Atoi(partsB[i])
+		if errA != nil || errB != nil {
+			if c := strings.Compare(partsA[i], partsB[i]); c != 0 {
+				return c
+			}
+			continue
+		}
+		if numA != numB {
+			if numA < numB {
+				return -1
+			}
+			return 1
+		}
+	}
+	return len(partsA) - len(partsB)
+}
+
+// isClusterVersionSupported checks the version against the supported versions of the add-on, they are regular
+// expressions such as v1.25.*.
+func isClusterVersionSupported(supportVersions []addons.SupportVersions, clusterType, version string) bool {
+	for _, sv := range supportVersions {
+		if clusterType != "" && sv.ClusterType != "" && sv.ClusterType != clusterType {
+			continue
+		}
+		for _, pattern := range sv.ClusterVersion {
+			if matched, err := regexp.MatchString("^"+pattern+"$", version); err == nil && matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// buildUpgradeAddons validates the installed add-ons against the target version. The add-ons which do not support
+// the target version are upgraded to their latest compatible version together with the cluster.
+func buildUpgradeAddons(installed []addons.Addon, templateList []templates.Template, clusterType,
+	targetVersion string) ([]map[string]interface{}, error) {
+	templateVersions := make(map[string][]addons.Versions)
+	for _, t := range templateList {
+		templateVersions[t.Metadata.Name] = t.Spec.Versions
+	}
+
+	result := make([]map[string]interface{}, 0)
+	incompatible := make([]string, 0)
+	for _, addon := range installed {
+		versions, ok := templateVersions[addon.Spec.AddonTemplateName]
+		if !ok {
+			continue
+		}
+
+		latest := ""
+		compatible := false
+		for _, v := range versions {
+			if !isClusterVersionSupported(v.SupportVersions, clusterType, targetVersion) {
+				continue
+			}
+			if v.Version == addon.Spec.Version {
+				compatible = true
+				break
+			}
+			if latest == "" || compareAddonVersions(v.Version, latest) > 0 {
+				latest = v.Version
+			}
+		}
+		if compatible {
+			continue
+		}
+		if latest == "" {
+			incompatible = append(incompatible, addon.Spec.AddonTemplateName)
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"addonTemplateName": addon.Spec.AddonTemplateName,
+			"operation":         "patch",
+			"version":           latest,
+			"values":            addon.Spec.Values,
+		})
+	}
+	if len(incompatible) > 0 {
+		return nil, fmt.Errorf("no version of the add-ons (%s) supports the cluster version %s",
+			strings.Join(incompatible, ", "), targetVersion)
+	}
+	return result, nil
+}
+
+func upgradeCluster(ctx context.Context, client, addonClient *golangsdk.ServiceClient,
+	opts clusterUpgradeOpts) error {
+	clusterId := opts.clusterId
+	targetVersion, err := getClusterTargetVersion(client, clusterId, opts.targetVersion)
+	if err != nil {
+		return err
+	}
+	if err = preCheckClusterUpgrade(ctx, client, opts, targetVersion); err != nil {
+		return err
+	}
+
+	installed, err := addons.List(addonClient, clusterId, addons.ListOpts{})
+	if err != nil {
+		return fmt.Errorf("error retrieving the add-ons of the CCE cluster (%s): %s", clusterId, err)
+	}
+	templateList, err := templates.List(addonClient, clusterId).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving the add-on templates: %s", err)
+	}
+	upgradeAddons, err := buildUpgradeAddons(installed, templateList, opts.clusterType, targetVersion)
+	if err != nil {
+		return fmt.Errorf("the CCE cluster (%s) can not be upgraded: %s", clusterId, err)
+	}
+
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+		JSONBody: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"apiVersion": "v3",
+				"kind":       "UpgradeTask",
+			},
+			"spec": map[string]interface{}{
+				"clusterUpgradeAction": map[string]interface{}{
+					"addons":        upgradeAddons,
+					"targetVersion": targetVersion,
+					"strategy": map[string]interface{}{
+						"type": "inPlaceRollingUpdate",
+					},
+				},
+			},
+		},
+	}
+	resp, err := client.Request("POST", buildClusterOperationPath(client, clusterId, "operation", "upgrade"),
+		&createOpt)
+	if err != nil {
+		return fmt.Errorf("error upgrading the CCE cluster (%s): %s", clusterId, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	taskId := utils.PathSearch("metadata.uid", respBody, "").(string)
+	if taskId == "" {
+		return fmt.Errorf("unable to find the upgrade task ID of the CCE cluster (%s)", clusterId)
+	}
+	taskPath := buildClusterOperationPath(client, clusterId, "operation", "upgrade", "tasks", taskId)
+	timeout, err := opts.remainingTimeout()
+	if err != nil {
+		return err
+	}
+	if err = waitForTaskCompleted(ctx, client, taskPath, timeout); err != nil {
+		return fmt.Errorf("error waiting for the upgrade of the CCE cluster (%s): %s", clusterId, err)
+	}
+
+	if timeout, err = opts.remainingTimeout(); err != nil {
+		return err
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			cluster, err := clusters.Get(client, clusterId).Extract()
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			if cluster.Status.Phase == "Available" {
+				return cluster, "COMPLETED", nil
+			}
+			return cluster, "PENDING", nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the CCE cluster (%s) to become available: %s", clusterId, err)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package cce
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/chnsz/golangsdk/openstack/cce/v3/addons"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/templates"
+)
+
+func TestMatchClusterTargetVersion(t *testing.T) {
+	targetVersions := []interface{}{"v1.23.8-r0", "v1.25", "v1.25.3-r10"}
+
+	testCases := []struct {
+		name     string
+		version  string
+		expected string
+		matched  bool
+	}{
+		{"exact version", "v1.25", "v1.25", true},
+		{"patch version", "v1.23", "v1.23.8-r0", true},
+		{"full version", "v1.25.3-r10", "v1.25.3-r10", true},
+		{"unavailable version", "v1.27", "", false},
+		{"partial number", "v1.2", "", false},
+	}
+
+	for _, tc := range testCases {
+		target, ok := matchClusterTargetVersion(targetVersions, tc.version)
+		if target != tc.expected || ok != tc.matched {
+			t.Errorf("[%s] expected (%s, %t), got (%s, %t)", tc.name, tc.expected, tc.matched, target, ok)
+		}
+	}
+}
+
+func TestCompareAddonVersions(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.25.1", "1.25.1", 0},
+		{"1.25.10", "1.25.9", 1},
+		{"1.3.2", "1.25.0", -1},
+		{"2.0", "2.0.1", -1},
+	}
+
+	for _, tc := range testCases {
+		result := compareAddonVersions(tc.a, tc.b)
+		if (result > 0) != (tc.expected > 0) || (result < 0) != (tc.expected < 0) {
+			t.Errorf("comparing %s with %s, expected the sign of %d, got %d", tc.a, tc.b, tc.expected, result)
+		}
+	}
+}
+
+func TestIsClusterVersionSupported(t *testing.T) {
+	supportVersions := []addons.SupportVersions{
+		{ClusterType: "VirtualMachine", ClusterVersion: []string{"v1.23.*", "v1.25.*"}},
+		{ClusterType: "BareMetal", ClusterVersion: []string{"v1.27.*"}},
+	}
+
+	testCases := []struct {
+		name        string
+		clusterType string
+		version     string
+		expected    bool
+	}{
+		{"supported version", "VirtualMachine", "v1.25.3-r10", true},
+		{"unsupported version", "VirtualMachine", "v1.27.1", false},
+		{"other cluster type", "BareMetal", "v1.27.1", true},
+		{"unspecified cluster type", "", "v1.27.1", true},
+	}
+
+	for _, tc := range testCases {
+		if result := isClusterVersionSupported(supportVersions, tc.clusterType, tc.version); result != tc.expected {
+			t.Errorf("[%s] expected %t, got %t", tc.name, tc.expected, result)
+		}
+	}
+}
+
+func TestBuildUpgradeAddons(t *testing.T) {
+	templateList := []templates.Template{
+		{
+			Metadata: templates.Metadata{Name: "coredns"},
+			Spec: templates.Spec{
+				Versions: []addons.Versions{
+					{Version: "1.23.1", SupportVersions: []addons.SupportVersions{
+						{ClusterVersion: []string{"v1.23.*"}},
+					}},
+					{Version: "1.25.1", SupportVersions: []addons.SupportVersions{
+						{ClusterVersion: []string{"v1.23.*", "v1.25.*"}},
+					}},
+					{Version: "1.25.11", SupportVersions: []addons.SupportVersions{
+						{ClusterVersion: []string{"v1.25.*"}},
+					}},
+				},
+			},
+		},
+		{
+			Metadata: templates.Metadata{Name: "everest"},
+			Spec: templates.Spec{
+				Versions: []addons.Versions{
+					{Version: "2.1.9", SupportVersions: []addons.SupportVersions{
+						{ClusterVersion: []string{"v1.23.*", "v1.25.*"}},
+					}},
+				},
+			},
+		},
+		{
+			Metadata: templates.Metadata{Name: "gpu-beta"},
+			Spec: templates.Spec{
+				Versions: []addons.Versions{
+					{Version: "1.2.15", SupportVersions: []addons.SupportVersions{
+						{ClusterVersion: []string{"v1.23.*"}},
+					}},
+				},
+			},
+		},
+	}
+	corednsValues := addons.Values{Basic: map[string]interface{}{"cluster_ip": "10.247.3.10"}}
+	coredns := addons.Addon{Spec: addons.Spec{AddonTemplateName: "coredns", Version: "1.23.1", Values: corednsValues}}
+	everest := addons.Addon{Spec: addons.Spec{AddonTemplateName: "everest", Version: "2.1.9"}}
+	gpu := addons.Addon{Spec: addons.Spec{AddonTemplateName: "gpu-beta", Version: "1.2.15"}}
+
+	result, err := buildUpgradeAddons([]addons.Addon{coredns, everest}, templateList, "VirtualMachine", "v1.25.3-r10")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := []map[string]interface{}{
+		{
+			"addonTemplateName": "coredns",
+			"operation":         "patch",
+			"version":           "1.25.11",
+			"values":            corednsValues,
+		},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	if _, err = buildUpgradeAddons([]addons.Addon{everest, gpu}, templateList, "VirtualMachine",
+		"v1.25.3-r10"); err == nil {
+		t.Errorf("expected the error of the incompatible add-on, got nil")
+	}
+}
+
+func TestClusterUpgradeRemainingTimeout(t *testing.T) {
+	opts := clusterUpgradeOpts{clusterId: "cluster", deadline: time.Now().Add(time.Hour)}
+	if timeout, err := opts.remainingTimeout(); err != nil || timeout > time.Hour || timeout < 59*time.Minute {
+		t.Errorf("expected the time left before the deadline, got %s, %v", timeout, err)
+	}
+
+	opts.deadline = time.Now().Add(-time.Second)
+	if _, err := opts.remainingTimeout(); err == nil {
+		t.Error("expected an error once the deadline is passed")
+	}
+}
@@ -0,0 +1,455 @@
+package cce
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/nodepools"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/nodes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/cce"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	nodePoolIdAnnotation = "kubernetes.io/node-pool.id"
+	nodeImageIdParam     = "alpha.cce/NodeImageID"
+)
+
+// nodeTemplateReplaceKeys are the parameters of the node template, the nodes are replaced when they are changed.
+var nodeTemplateReplaceKeys = []string{"flavor_id", "os", "extend_params.0.node_image_id"}
+
+// ResourceNodePool extends the node pool resource of huaweicloud with the rolling replacement of the nodes. When the
+// rolling_update is configured, the changes of the flavor, the OS and the image are applied by replacing the nodes
+// in batches instead of re-creating the node pool. The autoscaler add-on is required before the autoscaling of the
+// node pool is enabled.
+func ResourceNodePool() *schema.Resource {
+	resource := cce.ResourceNodePool()
+	resource.Schema["flavor_id"].ForceNew = false
+	resource.Schema["os"].ForceNew = false
+	extendParams := resource.Schema["extend_params"].Elem.(*schema.Resource)
+	extendParams.Schema["node_image_id"].ForceNew = false
+
+	resource.Schema["rolling_update"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_surge": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"max_unavailable": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"drain": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+			},
+		},
+	}
+	resource.Timeouts.Update = schema.DefaultTimeout(60 * time.Minute)
+	resource.CustomizeDiff = resourceNodePoolCustomizeDiff
+
+	nodePoolCreate := resource.CreateContext
+	resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := checkNodePoolAutoscaler(d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+		return nodePoolCreate(ctx, d, meta)
+	}
+	nodePoolRead, nodePoolUpdate := resource.ReadContext, resource.UpdateContext
+	resource.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := checkNodePoolAutoscaler(d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceNodePoolUpdate(ctx, d, meta, nodePoolRead, nodePoolUpdate)
+	}
+	return resource
+}
+
+// checkNodePoolAutoscaler validates the autoscaler add-on of the cluster when the autoscaling of the node pool is
+// enabled.
+func checkNodePoolAutoscaler(d *schema.ResourceData, meta interface{}) error {
+	if !d.Get("scall_enable").(bool) || (d.Id() != "" && !d.HasChange("scall_enable")) {
+		return nil
+	}
+
+	cfg := meta.(*config.Config)
+	addonClient, err := cfg.CceAddonV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating CCE add-on client: %s", err)
+	}
+	return checkAutoscalerInstalled(addonClient, d.Get("cluster_id").(string))
+}
+
+func checkNodeCountRange(minCount, maxCount int) error {
+	if minCount > maxCount {
+		return fmt.Errorf("min_node_count (%d) must be less than or equal to max_node_count (%d)", minCount, maxCount)
+	}
+	return nil
+}
+
+func resourceNodePoolCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("scall_enable").(bool) {
+		if err := checkNodeCountRange(d.Get("min_node_count").(int), d.Get("max_node_count").(int)); err != nil {
+			return err
+		}
+	}
+
+	rollingUpdate := d.Get("rolling_update").([]interface{})
+	if len(rollingUpdate) > 0 {
+		opts := buildRollingUpdateOpts(rollingUpdate)
+		if opts.maxSurge+opts.maxUnavailable < 1 {
+			return fmt.Errorf("at least one of max_surge and max_unavailable must be greater than 0")
+		}
+		return nil
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+	// Without the rolling update, the node pool is re-created as before.
+	for _, key := range nodeTemplateReplaceKeys {
+		if !d.HasChange(key) {
+			continue
+		}
+		if err := d.ForceNew(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type rollingUpdateOpts struct {
+	maxSurge       int
+	maxUnavailable int
+	drain          bool
+	deadline       time.Time
+}
+
+// remainingTimeout returns the timeout of the next step of the rolling update, which is the time left before the
+// deadline.
+func (opts rollingUpdateOpts) remainingTimeout(nodePoolId string) (time.Duration, error) {
+	remaining := time.Until(opts.deadline)
+	if remaining <= 0 {
+		return 0, fmt.Errorf("timeout while updating the nodes of the CCE node pool (%s)", nodePoolId)
+	}
+	return remaining, nil
+}
+
+func buildRollingUpdateOpts(rawParams []interface{}) rollingUpdateOpts {
+	params, ok := rawParams[0].(map[string]interface{})
+	if !ok {
+		return rollingUpdateOpts{maxSurge: 1, drain: true}
+	}
+	return rollingUpdateOpts{
+		maxSurge:       params["max_surge"].(int),
+		maxUnavailable: params["max_unavailable"].(int),
+		drain:          params["drain"].(bool),
+	}
+}
+
+// nodeTemplate is the part of the node template which the nodes are compared with.
+type nodeTemplate struct {
+	flavor  string
+	os      string
+	imageId string
+}
+
+func resourceNodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{},
+	nodePoolRead schema.ReadContextFunc, nodePoolUpdate schema.UpdateContextFunc) diag.Diagnostics {
+	if !d.HasChanges(nodeTemplateReplaceKeys...) {
+		return nodePoolUpdate(ctx, d, meta)
+	}
+	// All the steps of the rolling update and the other changes share the update timeout.
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	// The values are saved before the other changes are applied, the refresh of the state overwrites them.
+	target := nodeTemplate{
+		flavor:  d.Get("flavor_id").(string),
+		os:      d.Get("os").(string),
+		imageId: d.Get("extend_params.0.node_image_id").(string),
+	}
+	opts := buildRollingUpdateOpts(d.Get("rolling_update").([]interface{}))
+	opts.deadline = deadline
+
+	if diags := nodePoolUpdate(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+	if err = rollingUpdateNodePool(ctx, client, d.Get("cluster_id").(string), d.Id(), target, opts); err != nil {
+		return diag.FromErr(err)
+	}
+	return nodePoolRead(ctx, d, meta)
+}
+
+// updateNodePoolSpec modifies the specification of the node pool based on its current specification.
+func updateNodePoolSpec(client *golangsdk.ServiceClient, clusterId, nodePoolId string,
+	modify func(spec *nodepools.Spec)) error {
+	pool, err := nodepools.Get(client, clusterId, nodePoolId).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving CCE node pool (%s): %s", nodePoolId, err)
+	}
+
+	spec := pool.Spec
+	modify(&spec)
+	updateOpts := nodepools.UpdateOpts{
+		Kind:       "NodePool",
+		ApiVersion: "v3",
+		Metadata: nodepools.UpdateMetaData{
+			Name: pool.Metadata.Name,
+		},
+		Spec: nodepools.UpdateSpec{
+			Type:             spec.Type,
+			NodeTemplate:     spec.NodeTemplate,
+			InitialNodeCount: utils.Int(spec.InitialNodeCount),
+			Autoscaling:      spec.Autoscaling,
+		},
+	}
+	if _, err = nodepools.Update(client, clusterId, nodePoolId, updateOpts).Extract(); err != nil {
+		return fmt.Errorf("error updating CCE node pool (%s): %s", nodePoolId, err)
+	}
+	return nil
+}
+
+func applyNodeTemplate(spec *nodepools.Spec, target nodeTemplate) {
+	spec.NodeTemplate.Flavor = target.flavor
+	if target.os != "" {
+		spec.NodeTemplate.Os = target.os
+	}
+	if target.imageId != "" {
+		if spec.NodeTemplate.ExtendParam == nil {
+			spec.NodeTemplate.ExtendParam = make(map[string]interface{})
+		}
+		spec.NodeTemplate.ExtendParam[nodeImageIdParam] = target.imageId
+	}
+}
+
+// filterOutdatedNodes returns the IDs of the nodes of the node pool which do not match the node template.
+func filterOutdatedNodes(allNodes []nodes.Nodes, nodePoolId string, target nodeTemplate) []string {
+	result := make([]string, 0)
+	for _, node := range allNodes {
+		if node.Metadata.Annotations[nodePoolIdAnnotation] != nodePoolId {
+			continue
+		}
+		imageId, _ := node.Spec.ExtendParam[nodeImageIdParam].(string)
+		if node.Spec.Flavor != target.flavor || (target.os != "" && node.Spec.Os != target.os) ||
+			(target.imageId != "" && imageId != target.imageId) {
+			result = append(result, node.Metadata.Id)
+		}
+	}
+	return result
+}
+
+// splitNodeBatches splits the nodes into the batches which are replaced at the same time.
+func splitNodeBatches(nodeIds []string, size int) [][]string {
+	if size < 1 {
+		size = 1
+	}
+	result := make([][]string, 0, (len(nodeIds)+size-1)/size)
+	for start := 0; start < len(nodeIds); start += size {
+		end := start + size
+		if end > len(nodeIds) {
+			end = len(nodeIds)
+		}
+		result = append(result, nodeIds[start:end])
+	}
+	return result
+}
+
+func rollingUpdateNodePool(ctx context.Context, client *golangsdk.ServiceClient, clusterId, nodePoolId string,
+	target nodeTemplate, opts rollingUpdateOpts) error {
+	desiredCount := 0
+	err := updateNodePoolSpec(client, clusterId, nodePoolId, func(spec *nodepools.Spec) {
+		desiredCount = spec.InitialNodeCount
+		applyNodeTemplate(spec, target)
+	})
+	if err != nil {
+		return err
+	}
+	timeout, err := opts.remainingTimeout(nodePoolId)
+	if err != nil {
+		return err
+	}
+	if err = waitForNodePoolNodeCount(ctx, client, clusterId, nodePoolId, -1, timeout); err != nil {
+		return err
+	}
+
+	allNodes, err := nodes.List(client, clusterId, nodes.ListOpts{})
+	if err != nil {
+		return fmt.Errorf("error retrieving the nodes of the CCE cluster (%s): %s", clusterId, err)
+	}
+	outdatedNodes := filterOutdatedNodes(allNodes, nodePoolId, target)
+	if len(outdatedNodes) == 0 {
+		return nil
+	}
+
+	for _, batch := range splitNodeBatches(outdatedNodes, opts.maxSurge+opts.maxUnavailable) {
+		// The surge nodes are created with the new template before the outdated nodes are removed.
+		surge := opts.maxSurge
+		if surge > len(batch) {
+			surge = len(batch)
+		}
+		if surge > 0 {
+			if timeout, err = opts.remainingTimeout(nodePoolId); err != nil {
+				return err
+			}
+			if err = scaleNodePool(ctx, client, clusterId, nodePoolId, desiredCount+surge, timeout); err != nil {
+				return err
+			}
+		}
+
+		if opts.drain {
+			if timeout, err = opts.remainingTimeout(nodePoolId); err != nil {
+				return err
+			}
+			if err = drainNodes(ctx, client, clusterId, batch, timeout); err != nil {
+				return err
+			}
+		}
+		for _, nodeId := range batch {
+			if timeout, err = opts.remainingTimeout(nodePoolId); err != nil {
+				return err
+			}
+			if err = scaleDownNode(ctx, client, clusterId, nodeId, timeout); err != nil {
+				return err
+			}
+		}
+		if timeout, err = opts.remainingTimeout(nodePoolId); err != nil {
+			return err
+		}
+		if err = scaleNodePool(ctx, client, clusterId, nodePoolId, desiredCount, timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func scaleNodePool(ctx context.Context, client *golangsdk.ServiceClient, clusterId, nodePoolId string, count int,
+	timeout time.Duration) error {
+	err := updateNodePoolSpec(client, clusterId, nodePoolId, func(spec *nodepools.Spec) {
+		spec.InitialNodeCount = count
+	})
+	if err != nil {
+		return err
+	}
+	return waitForNodePoolNodeCount(ctx, client, clusterId, nodePoolId, count, timeout)
+}
+
+// waitForNodePoolNodeCount waits for the node pool to be synchronized with the expected node count, the negative
+// count means that any node count is accepted.
+func waitForNodePoolNodeCount(ctx context.Context, client *golangsdk.ServiceClient, clusterId, nodePoolId string,
+	count int, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			pool, err := nodepools.Get(client, clusterId, nodePoolId).Extract()
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			if pool.Status.Phase == "Error" {
+				return pool, "ERROR", fmt.Errorf("unexpect status (%s)", pool.Status.Phase)
+			}
+			if pool.Status.Phase == "" && (count < 0 || pool.Status.CurrentNode == count) {
+				return pool, "COMPLETED", nil
+			}
+			return pool, "PENDING", nil
+		},
+		Timeout:      timeout,
+		Delay:        30 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for CCE node pool (%s) to become available: %s", nodePoolId, err)
+	}
+	return nil
+}
+
+// drainNodes evicts the pods from the nodes, the daemon sets are ignored.
+func drainNodes(ctx context.Context, client *golangsdk.ServiceClient, clusterId string, nodeIds []string,
+	timeout time.Duration) error {
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+		JSONBody: map[string]interface{}{
+			"apiVersion": "v3",
+			"kind":       "Drain",
+			"nodeIdList": nodeIds,
+			"spec": map[string]interface{}{
+				"dryRun":             false,
+				"gracePeriodSeconds": -1,
+				"ignoreDaemonSets":   true,
+				"deleteLocalData":    true,
+			},
+		},
+	}
+	drainPath := buildClusterOperationPath(client, clusterId, "nodes", "operation", "drain")
+	resp, err := client.Request("POST", drainPath, &createOpt)
+	if err != nil {
+		return fmt.Errorf("error draining the CCE nodes (%v): %s", nodeIds, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	jobId := utils.PathSearch("jobid", respBody, "").(string)
+	if jobId == "" {
+		return fmt.Errorf("unable to find the job ID of draining the CCE nodes (%v)", nodeIds)
+	}
+	if err = waitForJobCompleted(ctx, client, jobId, timeout); err != nil {
+		return fmt.Errorf("error waiting for the CCE nodes (%v) to be drained: %s", nodeIds, err)
+	}
+	return nil
+}
+
+// scaleDownNode deletes the node and reduces the expected node count of its node pool.
+func scaleDownNode(ctx context.Context, client *golangsdk.ServiceClient, clusterId, nodeId string,
+	timeout time.Duration) error {
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	deletePath := buildClusterOperationPath(client, clusterId, "nodes", nodeId) + "?nodepoolScaleDown=ScaleDown"
+	resp, err := client.Request("DELETE", deletePath, &deleteOpt)
+	if err != nil {
+		return fmt.Errorf("error deleting the CCE node (%s): %s", nodeId, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	jobId := utils.PathSearch("status.jobID", respBody, "").(string)
+	if jobId == "" {
+		return fmt.Errorf("unable to find the job ID of deleting the CCE node (%s)", nodeId)
+	}
+	if err = waitForJobCompleted(ctx, client, jobId, timeout); err != nil {
+		return fmt.Errorf("error waiting for the CCE node (%s) to be deleted: %s", nodeId, err)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package cce
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/chnsz/golangsdk/openstack/cce/v3/nodes"
+)
+
+func TestFilterOutdatedNodes(t *testing.T) {
+	buildNode := func(id, nodePoolId, flavor, os, imageId string) nodes.Nodes {
+		node := nodes.Nodes{
+			Metadata: nodes.Metadata{
+				Id:          id,
+				Annotations: map[string]string{nodePoolIdAnnotation: nodePoolId},
+			},
+			Spec: nodes.Spec{Flavor: flavor, Os: os},
+		}
+		if imageId != "" {
+			node.Spec.ExtendParam = map[string]interface{}{nodeImageIdParam: imageId}
+		}
+		return node
+	}
+	allNodes := []nodes.Nodes{
+		buildNode("node-1", "pool-1", "s6.large.2", "EulerOS 2.9", ""),
+		buildNode("node-2", "pool-1", "s6.xlarge.2", "EulerOS 2.9", ""),
+		buildNode("node-3", "pool-1", "s6.xlarge.2", "CentOS 7.6", ""),
+		buildNode("node-4", "pool-2", "s6.large.2", "EulerOS 2.9", ""),
+		buildNode("node-5", "pool-1", "s6.xlarge.2", "EulerOS 2.9", "image-1"),
+	}
+
+	testCases := []struct {
+		name     string
+		target   nodeTemplate
+		expected []string
+	}{
+		{
+			name:     "flavor changed",
+			target:   nodeTemplate{flavor: "s6.xlarge.2"},
+			expected: []string{"node-1"},
+		},
+		{
+			name:     "OS changed",
+			target:   nodeTemplate{flavor: "s6.xlarge.2", os: "CentOS 7.6"},
+			expected: []string{"node-1", "node-2", "node-5"},
+		},
+		{
+			name:     "image changed",
+			target:   nodeTemplate{flavor: "s6.xlarge.2", os: "EulerOS 2.9", imageId: "image-1"},
+			expected: []string{"node-1", "node-2", "node-3"},
+		},
+	}
+
+	for _, tc := range testCases {
+		result := filterOutdatedNodes(allNodes, "pool-1", tc.target)
+		if !reflect.DeepEqual(result, tc.expected) {
+			t.Errorf("[%s] expected %v, got %v", tc.name, tc.expected, result)
+		}
+	}
+}
+
+func TestSplitNodeBatches(t *testing.T) {
+	nodeIds := []string{"node-1", "node-2", "node-3", "node-4", "node-5"}
+
+	testCases := []struct {
+		name     string
+		size     int
+		expected [][]string
+	}{
+		{"one by one", 1, [][]string{{"node-1"}, {"node-2"}, {"node-3"}, {"node-4"}, {"node-5"}}},
+		{"uneven batches", 2, [][]string{{"node-1", "node-2"}, {"node-3", "node-4"}, {"node-5"}}},
+		{"single batch", 10, [][]string{nodeIds}},
+		{"invalid size", 0, [][]string{{"node-1"}, {"node-2"}, {"node-3"}, {"node-4"}, {"node-5"}}},
+	}
+
+	for _, tc := range testCases {
+		if result := splitNodeBatches(nodeIds, tc.size); !reflect.DeepEqual(result, tc.expected) {
+			t.Errorf("[%s] expected %v, got %v", tc.name, tc.expected, result)
+		}
+	}
+}
+
+func TestBuildRollingUpdateOpts(t *testing.T) {
+	rawParams := []interface{}{
+		map[string]interface{}{"max_surge": 2, "max_unavailable": 1, "drain": false},
+	}
+	expected := rollingUpdateOpts{maxSurge: 2, maxUnavailable: 1, drain: false}
+	if result := buildRollingUpdateOpts(rawParams); result != expected {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	expected = rollingUpdateOpts{maxSurge: 1, drain: true}
+	if result := buildRollingUpdateOpts([]interface{}{nil}); result != expected {
+		t.Errorf("expected the default options %v, got %v", expected, result)
+	}
+}
+
+func TestRollingUpdateRemainingTimeout(t *testing.T) {
+	opts := rollingUpdateOpts{deadline: time.Now().Add(time.Hour)}
+	if timeout, err := opts.remainingTimeout("pool"); err != nil || timeout > time.Hour || timeout < 59*time.Minute {
+		t.Errorf("expected the time left before the deadline, got %s, %v", timeout, err)
+	}
+
+	opts.deadline = time.Now().Add(-time.Second)
+	if _, err := opts.remainingTimeout("pool"); err == nil {
+		t.Error("expected an error once the deadline is passed")
+	}
+}
+
+func TestCheckNodeCountRange(t *testing.T) {
+	testCases := []struct {
+		minCount, maxCount int
+		valid              bool
+	}{
+		{1, 3, true},
+		{2, 2, true},
+		{0, 0, true},
+		{3, 1, false},
+	}
+
+	for _, tc := range testCases {
+		if err := checkNodeCountRange(tc.minCount, tc.maxCount); (err == nil) != tc.valid {
+			t.Errorf("checking the range from %d to %d, expected valid to be %t, got the error: %v",
+				tc.minCount, tc.maxCount, tc.valid, err)
+		}
+	}
+}
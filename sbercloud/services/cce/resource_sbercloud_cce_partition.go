@@ -0,0 +1,55 @@
+package cce
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk/openstack/cce/v3/partitions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/cce"
+)
+
+// ResourcePartition manages the partitions of the CCE Turbo clusters which places the nodes in multiple AZs, such as
+// the edge AZs. The partition resource of huaweicloud refreshes the state as a node after the update and misses the
+// name in the schema, both of them are fixed here.
+func ResourcePartition() *schema.Resource {
+	resource := cce.ResourcePartition()
+	resource.Description = ""
+	resource.Schema["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	partitionRead := resource.ReadContext
+	resource.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return resourcePartitionUpdate(ctx, d, meta, partitionRead)
+	}
+	return resource
+}
+
+func resourcePartitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{},
+	partitionRead schema.ReadContextFunc) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	if d.HasChange("container_subnet_ids") {
+		containerSubnetIds := d.Get("container_subnet_ids").(*schema.Set).List()
+		containerNetwork := make([]partitions.ContainerNetwork, len(containerSubnetIds))
+		for i, subnetId := range containerSubnetIds {
+			containerNetwork[i] = partitions.ContainerNetwork{SubnetID: subnetId.(string)}
+		}
+
+		var updateOpts partitions.UpdateOpts
+		updateOpts.Metadata.ContainerNetwork = containerNetwork
+		_, err = partitions.Update(client, d.Get("cluster_id").(string), d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("error updating CCE partition (%s): %s", d.Id(), err)
+		}
+	}
+	return partitionRead(ctx, d, meta)
+}
@@ -0,0 +1,290 @@
+package dc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceDcConnections() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDcConnectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"connection_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The ID of the physical connection.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The name of the physical connection.`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The type of the physical connection.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The status of the physical connection.`,
+			},
+			"port_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The port type of the physical connection.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The enterprise project ID to which the physical connections belong.`,
+			},
+			"direct_connects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        connectionSchema(),
+				Description: `The list of the physical connections.`,
+			},
+		},
+	}
+}
+
+func connectionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the physical connection.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The name of the physical connection.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The description of the physical connection.`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The type of the physical connection.`,
+			},
+			"port_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The port type of the physical connection.`,
+			},
+			"bandwidth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The bandwidth of the physical connection, in Mbit/s.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The access location of the physical connection.`,
+			},
+			"peer_location": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The location of the on-premises facility at the other end of the physical connection.`,
+			},
+			"device_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the device connected to the physical connection.`,
+			},
+			"interface_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The name of the interface connected to the physical connection.`,
+			},
+			"provider": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The carrier who provides the leased line.`,
+			},
+			"provider_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The status of the carrier's leased line.`,
+			},
+			"hosting_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the operations connection on which the hosted connection is created.`,
+			},
+			"vlan": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The VLAN allocated to the hosted connection.`,
+			},
+			"charge_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The billing mode of the physical connection.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The status of the physical connection.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The enterprise project ID to which the physical connection belongs.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The creation time of the physical connection.`,
+			},
+		},
+	}
+}
+
+func dataSourceDcConnectionsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// listConnections: Query the physical connections
+	var (
+		listConnectionsHttpUrl = "v3/{project_id}/dcaas/direct-connects"
+		listConnectionsProduct = "dc"
+	)
+	listConnectionsClient, err := cfg.NewServiceClient(listConnectionsProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DC client: %s", err)
+	}
+
+	listConnectionsPath := listConnectionsClient.Endpoint + listConnectionsHttpUrl
+	listConnectionsPath = strings.ReplaceAll(listConnectionsPath, "{project_id}", listConnectionsClient.ProjectID)
+	listConnectionsPath += buildListConnectionsQueryParams(d)
+
+	listConnectionsOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+
+	connections := make([]interface{}, 0)
+	marker := ""
+	for {
+		currentPath := listConnectionsPath
+		if marker != "" {
+			currentPath += fmt.Sprintf("&marker=%s", marker)
+		}
+		listConnectionsResp, err := listConnectionsClient.Request("GET", currentPath, &listConnectionsOpt)
+		if err != nil {
+			return diag.Errorf("error retrieving DC physical connections: %s", err)
+		}
+
+		listConnectionsRespBody, err := utils.FlattenResponse(listConnectionsResp)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		connections = append(connections,
+			utils.PathSearch("direct_connects", listConnectionsRespBody, make([]interface{}, 0)).([]interface{})...)
+		marker = utils.PathSearch("page_info.next_marker", listConnectionsRespBody, "").(string)
+		if marker == "" {
+			break
+		}
+	}
+
+	dataSourceId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(dataSourceId)
+
+	mErr := multierror.Append(
+		d.Set("region", region),
+		d.Set("direct_connects", flattenListConnections(d, connections)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+// buildListConnectionsQueryParams builds the filters supported by the API, the others are
+// applied when flattening the response.
+func buildListConnectionsQueryParams(d *schema.ResourceData) string {
+	res := "?limit=100"
+	if v, ok := d.GetOk("connection_id"); ok {
+		res = fmt.Sprintf("%s&id=%v", res, v)
+	}
+	if v, ok := d.GetOk("name"); ok {
+		res = fmt.Sprintf("%s&name=%v", res, v)
+	}
+	if v, ok := d.GetOk("enterprise_project_id"); ok {
+		res = fmt.Sprintf("%s&enterprise_project_id=%v", res, v)
+	}
+	return res
+}
+
+func flattenListConnections(d *schema.ResourceData, connections []interface{}) []map[string]interface{} {
+	filters := map[string]string{
+		"type":      d.Get("type").(string),
+		"status":    d.Get("status").(string),
+		"port_type": d.Get("port_type").(string),
+	}
+
+	rst := make([]map[string]interface{}, 0, len(connections))
+	for _, v := range connections {
+		if !matchConnectionFilters(v, filters) {
+			continue
+		}
+		rst = append(rst, map[string]interface{}{
+			"id":                    utils.PathSearch("id", v, nil),
+			"name":                  utils.PathSearch("name", v, nil),
+			"description":           utils.PathSearch("description", v, nil),
+			"type":                  utils.PathSearch("type", v, nil),
+			"port_type":             utils.PathSearch("port_type", v, nil),
+			"bandwidth":             utils.PathSearch("bandwidth", v, nil),
+			"location":              utils.PathSearch("location", v, nil),
+			"peer_location":         utils.PathSearch("peer_location", v, nil),
+			"device_id":             utils.PathSearch("device_id", v, nil),
+			"interface_name":        utils.PathSearch("interface_name", v, nil),
+			"provider":              utils.PathSearch("provider", v, nil),
+			"provider_status":       utils.PathSearch("provider_status", v, nil),
+			"hosting_id":            utils.PathSearch("hosting_id", v, nil),
+			"vlan":                  utils.PathSearch("vlan", v, nil),
+			"charge_mode":           utils.PathSearch("charge_mode", v, nil),
+			"status":                utils.PathSearch("status", v, nil),
+			"enterprise_project_id": utils.PathSearch("enterprise_project_id", v, nil),
+			"created_at":            utils.PathSearch("create_time", v, nil),
+		})
+	}
+	return rst
+}
+
+func matchConnectionFilters(connection interface{}, filters map[string]string) bool {
+	for k, expected := range filters {
+		if expected == "" {
+			continue
+		}
+		if fmt.Sprint(utils.PathSearch(k, connection, "")) != expected {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,123 @@
+package dc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// testConnectionsPages are the pages of the physical connection API, indexed by the marker.
+var testConnectionsPages = map[string]string{
+	"": `{
+  "direct_connects": [
+    {"id": "dc-1", "name": "conn-1", "type": "standard", "port_type": "10G", "bandwidth": 1000,
+     "status": "ACTIVE", "create_time": "2023-06-01T10:00:00.000Z"},
+    {"id": "dc-2", "name": "conn-2", "type": "hosted", "port_type": "1G", "bandwidth": 50, "vlan": 100,
+     "hosting_id": "dc-1", "status": "PENDING_PAY"}
+  ],
+  "page_info": {"next_marker": "dc-2"}
+}`,
+	"dc-2": `{
+  "direct_connects": [
+    {"id": "dc-3", "name": "conn-3", "type": "hosted", "port_type": "1G", "bandwidth": 100, "vlan": 200,
+     "hosting_id": "dc-1", "status": "ACTIVE"}
+  ],
+  "page_info": {}
+}`,
+}
+
+// testConnectionsConfig returns a config which sends the DC requests to a local server
+// answering the physical connection API.
+func testConnectionsConfig(t *testing.T) *config.Config {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, ok := testConnectionsPages[r.URL.Query().Get("marker")]
+		if r.Method != "GET" || r.URL.Path != "/v3/project-id/dcaas/direct-connects" || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("limit") != "100" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, page)
+	}))
+	t.Cleanup(server.Close)
+
+	return &config.Config{
+		Region:    "ru-moscow-1",
+		Endpoints: map[string]string{"dc": server.URL + "/"},
+		HwClient:  &golangsdk.ProviderClient{ProjectID: "project-id"},
+	}
+}
+
+func TestDataSourceDcConnectionsRead(t *testing.T) {
+	cases := []struct {
+		raw      map[string]interface{}
+		expected []string
+	}{
+		{
+			raw:      map[string]interface{}{},
+			expected: []string{"dc-1", "dc-2", "dc-3"},
+		},
+		{
+			raw:      map[string]interface{}{"type": "hosted"},
+			expected: []string{"dc-2", "dc-3"},
+		},
+		{
+			raw:      map[string]interface{}{"type": "hosted", "status": "ACTIVE"},
+			expected: []string{"dc-3"},
+		},
+		{
+			raw:      map[string]interface{}{"port_type": "40G"},
+			expected: []string{},
+		},
+	}
+
+	cfg := testConnectionsConfig(t)
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, DataSourceDcConnections().Schema, tc.raw)
+		if diags := dataSourceDcConnectionsRead(context.Background(), d, cfg); diags.HasError() {
+			t.Fatalf("error reading the physical connections: %v", diags)
+		}
+
+		connections := d.Get("direct_connects").([]interface{})
+		if len(connections) != len(tc.expected) {
+			t.Fatalf("%v: expected the physical connections %v, got %v", tc.raw, tc.expected, connections)
+		}
+		for i, id := range tc.expected {
+			if connections[i].(map[string]interface{})["id"] != id {
+				t.Errorf("%v: expected the physical connections %v, got %v", tc.raw, tc.expected, connections)
+			}
+		}
+	}
+}
+
+func TestDataSourceDcConnectionsRead_attributes(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceDcConnections().Schema, map[string]interface{}{})
+	if diags := dataSourceDcConnectionsRead(context.Background(), d, testConnectionsConfig(t)); diags.HasError() {
+		t.Fatalf("error reading the physical connections: %v", diags)
+	}
+
+	expected := map[string]interface{}{
+		"direct_connects.0.bandwidth":  1000,
+		"direct_connects.0.port_type":  "10G",
+		"direct_connects.0.created_at": "2023-06-01T10:00:00.000Z",
+		"direct_connects.1.vlan":       100,
+		"direct_connects.1.hosting_id": "dc-1",
+		"direct_connects.1.status":     "PENDING_PAY",
+		"region":                       "ru-moscow-1",
+	}
+	for k, v := range expected {
+		if d.Get(k) != v {
+			t.Errorf("expected %s to be %v, got %v", k, v, d.Get(k))
+		}
+	}
+}
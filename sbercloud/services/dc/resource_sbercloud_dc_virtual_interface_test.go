@@ -0,0 +1,63 @@
+package dc
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+)
+
+// testInterfaceClient returns a client of a local server which answers the virtual interface
+// API with the given status, the interface is considered deleted if the status is empty.
+func testInterfaceClient(t *testing.T, status string) *golangsdk.ServiceClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/project-id/dcaas/virtual-interfaces/vif-1" || status == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"virtual_interface":{"id":"vif-1","status":%q}}`, status)
+	}))
+	t.Cleanup(server.Close)
+
+	return &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{HTTPClient: *server.Client()},
+		ResourceBase:   server.URL + "/v3/project-id/",
+	}
+}
+
+func TestVirtualInterfaceStatusRefreshFunc(t *testing.T) {
+	createPending := []string{"PENDING_CREATE", "BUILD"}
+	cases := []struct {
+		status   string
+		pending  []string
+		expected string
+		isErr    bool
+	}{
+		{status: "PENDING_CREATE", pending: createPending, expected: "PENDING"},
+		{status: "BUILD", pending: createPending, expected: "PENDING"},
+		{status: "ACTIVE", pending: createPending, expected: "COMPLETED"},
+		// the interface is provisioned even if the peer is not up yet
+		{status: "DOWN", pending: createPending, expected: "COMPLETED"},
+		{status: "ERROR", pending: createPending, expected: "ERROR", isErr: true},
+		{status: "REJECTED", pending: createPending, expected: "ERROR", isErr: true},
+		{status: "PENDING_UPDATE", pending: []string{"PENDING_UPDATE"}, expected: "PENDING"},
+		{status: "ACTIVE", pending: []string{"PENDING_UPDATE"}, expected: "COMPLETED"},
+		{status: "PENDING_DELETE", expected: "PENDING"},
+		{status: "DELETED", expected: "DELETED"},
+		{status: "", expected: "DELETED"},
+		// the interface must not disappear while it is being created
+		{status: "", pending: createPending, expected: "ERROR", isErr: true},
+	}
+
+	for _, tc := range cases {
+		refresh := virtualInterfaceStatusRefreshFunc(testInterfaceClient(t, tc.status), "vif-1", tc.pending)
+		_, state, err := refresh()
+		if state != tc.expected || (err != nil) != tc.isErr {
+			t.Errorf("[%s %v] expected the state %s (error: %t), got %s (%v)", tc.status, tc.pending,
+				tc.expected, tc.isErr, state, err)
+		}
+	}
+}
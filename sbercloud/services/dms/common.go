@@ -0,0 +1,76 @@
+package dms
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// buildInstancePath builds the request path of the APIs under the DMS instance, the project ID and the instance ID in
+// the URL are replaced.
+func buildInstancePath(client *golangsdk.ServiceClient, httpUrl, instanceId string) string {
+	path := client.Endpoint + strings.ReplaceAll(httpUrl, "{project_id}", client.ProjectID)
+	return strings.ReplaceAll(path, "{instance_id}", instanceId)
+}
+
+// encodeRabbitmqPathParam escapes the slashes in the names of the vhosts, exchanges and queues, the RabbitMQ APIs
+// require the slashes in the path parameters to be replaced with the placeholders.
+func encodeRabbitmqPathParam(param string) string {
+	param = strings.ReplaceAll(param, "/", "__F_SLASH__")
+	return strings.ReplaceAll(param, "\\", "__B_SLASH__")
+}
+
+// buildRabbitmqVhostPath builds the request path of the APIs under the RabbitMQ vhost.
+func buildRabbitmqVhostPath(client *golangsdk.ServiceClient, httpUrl, instanceId, vhost string) string {
+	path := buildInstancePath(client, httpUrl, instanceId)
+	return strings.ReplaceAll(path, "{vhost}", encodeRabbitmqPathParam(vhost))
+}
+
+// parseRabbitmqResourceId splits the ID of the RabbitMQ resources by commas, the vhost names may contain slashes.
+// The format is the expected format of the ID, such as '<instance_id>,<vhost>,<name>'.
+func parseRabbitmqResourceId(id, format string) ([]string, error) {
+	count := strings.Count(format, ",") + 1
+	parts := strings.SplitN(id, ",", count)
+	if len(parts) != count {
+		return nil, fmt.Errorf("invalid ID format, want '%s', but got '%s'", format, id)
+	}
+	for _, part := range parts[:count-1] {
+		if part == "" {
+			return nil, fmt.Errorf("invalid ID format, want '%s', but got '%s'", format, id)
+		}
+	}
+	return parts, nil
+}
+
+// listRabbitmqItems queries all pages of the RabbitMQ list APIs, the pages are returned in the items.
+func listRabbitmqItems(client *golangsdk.ServiceClient, listPath string) ([]interface{}, error) {
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	separator := "?"
+	if strings.Contains(listPath, "?") {
+		separator = "&"
+	}
+
+	var result []interface{}
+	for offset := 0; ; {
+		resp, err := client.Request("GET", fmt.Sprintf("%s%soffset=%d&limit=50", listPath, separator, offset), &listOpt)
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		items := utils.PathSearch("items", respBody, make([]interface{}, 0)).([]interface{})
+		result = append(result, items...)
+		offset += len(items)
+		if len(items) == 0 || offset >= int(utils.PathSearch("total", respBody, float64(0)).(float64)) {
+			return result, nil
+		}
+	}
+}
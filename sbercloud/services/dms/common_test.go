@@ -0,0 +1,56 @@
+package dms
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEncodeRabbitmqPathParam(t *testing.T) {
+	testCases := map[string]string{
+		"vhost":     "vhost",
+		"/":         "__F_SLASH__",
+		"/a/b":      "__F_SLASH__a__F_SLASH__b",
+		"a\\b":      "a__B_SLASH__b",
+		"/test\\01": "__F_SLASH__test__B_SLASH__01",
+	}
+
+	for param, expected := range testCases {
+		if got := encodeRabbitmqPathParam(param); got != expected {
+			t.Errorf("encoding %q, expected %q, got %q", param, expected, got)
+		}
+	}
+}
+
+func TestParseRabbitmqResourceId(t *testing.T) {
+	testCases := []struct {
+		id, format string
+		expected   []string
+	}{
+		{"instance,/", rabbitmqVhostIdFormat, []string{"instance", "/"}},
+		{"instance,/,queue", rabbitmqQueueIdFormat, []string{"instance", "/", "queue"}},
+		{"instance,vhost,ex,Queue,queue,", rabbitmqBindingIdFormat,
+			[]string{"instance", "vhost", "ex", "Queue", "queue", ""}},
+		{"instance,vhost,ex,Queue,queue,a,b", rabbitmqBindingIdFormat,
+			[]string{"instance", "vhost", "ex", "Queue", "queue", "a,b"}},
+		{"instance", rabbitmqVhostIdFormat, nil},
+		{"instance,,queue", rabbitmqQueueIdFormat, nil},
+		{"instance/vhost/queue", rabbitmqQueueIdFormat, nil},
+	}
+
+	for _, tc := range testCases {
+		parts, err := parseRabbitmqResourceId(tc.id, tc.format)
+		if tc.expected == nil {
+			if err == nil {
+				t.Errorf("parsing %q, expected an error, got %v", tc.id, parts)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parsing %q, unexpected error: %s", tc.id, err)
+			continue
+		}
+		if !reflect.DeepEqual(parts, tc.expected) {
+			t.Errorf("parsing %q, expected %v, got %v", tc.id, tc.expected, parts)
+		}
+	}
+}
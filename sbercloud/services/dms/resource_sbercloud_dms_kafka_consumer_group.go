@@ -0,0 +1,248 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	kafkaConsumerGroupCreateHttpUrl = "v2/{project_id}/kafka/instances/{instance_id}/group"
+	kafkaConsumerGroupsHttpUrl      = "v2/{project_id}/instances/{instance_id}/groups"
+	kafkaConsumerGroupUpdateHttpUrl = "v2/kafka/{project_id}/instances/{instance_id}/groups/{group}"
+)
+
+// ResourceDmsKafkaConsumerGroup manages a consumer group of the Kafka instance, the ID is formatted as
+// <instance_id>/<name>.
+func ResourceDmsKafkaConsumerGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsKafkaConsumerGroupCreate,
+		ReadContext:   resourceDmsKafkaConsumerGroupRead,
+		UpdateContext: resourceDmsKafkaConsumerGroupUpdate,
+		DeleteContext: resourceDmsKafkaConsumerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"coordinator_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"lag": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// parseKafkaConsumerGroupId splits the resource ID into the instance ID and the group name.
+func parseKafkaConsumerGroupId(id string) (instanceId, name string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format, want '<instance_id>/<name>', but got '%s'", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// findKafkaConsumerGroup picks the group by the exact name, the names are compared in Go as they may contain
+// the characters of the JMESPath literals.
+func findKafkaConsumerGroup(groups []interface{}, name string) interface{} {
+	for _, group := range groups {
+		if utils.PathSearch("group_id", group, "").(string) == name {
+			return group
+		}
+	}
+	return nil
+}
+
+// getKafkaConsumerGroup searches the groups fuzzily by the name page by page, and picks the group by the exact
+// name. A 404 error is returned if the group does not exist.
+func getKafkaConsumerGroup(client *golangsdk.ServiceClient, instanceId, name string) (interface{}, error) {
+	listPath := buildInstancePath(client, kafkaConsumerGroupsHttpUrl, instanceId)
+	listPath += fmt.Sprintf("?group=%s", url.QueryEscape(name))
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+
+	for offset := 0; ; {
+		resp, err := client.Request("GET", fmt.Sprintf("%s&offset=%d&limit=50", listPath, offset), &listOpt)
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		groups := utils.PathSearch("groups", respBody, make([]interface{}, 0)).([]interface{})
+		if group := findKafkaConsumerGroup(groups, name); group != nil {
+			return group, nil
+		}
+		offset += len(groups)
+		if len(groups) == 0 || offset >= int(utils.PathSearch("total", respBody, float64(0)).(float64)) {
+			return nil, golangsdk.ErrDefault404{}
+		}
+	}
+}
+
+func resourceDmsKafkaConsumerGroupCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201, 204},
+		JSONBody: map[string]interface{}{
+			"group_name": name,
+			"group_desc": d.Get("description"),
+		},
+	}
+	createPath := buildInstancePath(client, kafkaConsumerGroupCreateHttpUrl, instanceId)
+	if _, err = client.Request("POST", createPath, &createOpt); err != nil {
+		return diag.Errorf("error creating DMS kafka consumer group: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceId, name))
+	return resourceDmsKafkaConsumerGroupRead(ctx, d, meta)
+}
+
+func resourceDmsKafkaConsumerGroupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DmsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId, name, err := parseKafkaConsumerGroupId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	group, err := getKafkaConsumerGroup(client, instanceId, name)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DMS kafka consumer group")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", instanceId),
+		d.Set("name", name),
+		d.Set("description", utils.PathSearch("group_desc", group, nil)),
+		d.Set("state", utils.PathSearch("state", group, nil)),
+		d.Set("coordinator_id", utils.PathSearch("coordinator_id", group, nil)),
+		d.Set("lag", utils.PathSearch("lag", group, nil)),
+		d.Set("created_at", utils.FormatTimeStampRFC3339(
+			int64(utils.PathSearch("createdAt", group, float64(0)).(float64))/1000, false)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DMS kafka consumer group fields: %s", err)
+	}
+	return nil
+}
+
+func resourceDmsKafkaConsumerGroupUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	updatePath := buildInstancePath(client, kafkaConsumerGroupUpdateHttpUrl, d.Get("instance_id").(string))
+	updatePath = strings.ReplaceAll(updatePath, "{group}", url.PathEscape(name))
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+		JSONBody: map[string]interface{}{
+			"group_name": name,
+			"group_desc": d.Get("description"),
+		},
+	}
+	if _, err = client.Request("PUT", updatePath, &updateOpt); err != nil {
+		return diag.Errorf("error updating DMS kafka consumer group (%s): %s", d.Id(), err)
+	}
+	return resourceDmsKafkaConsumerGroupRead(ctx, d, meta)
+}
+
+func resourceDmsKafkaConsumerGroupDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	deletePath := buildInstancePath(client, kafkaConsumerGroupsHttpUrl, d.Get("instance_id").(string))
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody: map[string]interface{}{
+			"group_ids": []string{name},
+		},
+	}
+	resp, err := client.Request("POST", deletePath+"/batch-delete", &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DMS kafka consumer group")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	failedGroups := utils.PathSearch("failed_groups", respBody, make([]interface{}, 0)).([]interface{})
+	if failedGroup := findKafkaConsumerGroup(failedGroups, name); failedGroup != nil {
+		return diag.Errorf("error deleting DMS kafka consumer group (%s): %v", d.Id(),
+			utils.PathSearch("error_message", failedGroup, nil))
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package dms
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+)
+
+func TestFindKafkaConsumerGroup(t *testing.T) {
+	groups := []interface{}{
+		map[string]interface{}{"group_id": "group-a"},
+		map[string]interface{}{"group_id": "group-a'b"},
+		map[string]interface{}{"group_id": "group"},
+	}
+	testCases := map[string]interface{}{
+		"group":     groups[2],
+		"group-a'b": groups[1],
+		"group-":    nil,
+	}
+
+	for name, expected := range testCases {
+		if got := findKafkaConsumerGroup(groups, name); !reflect.DeepEqual(got, expected) {
+			t.Errorf("finding %q, expected %v, got %v", name, expected, got)
+		}
+	}
+}
+
+func TestGetKafkaConsumerGroup(t *testing.T) {
+	// the fuzzy search returns 3 groups, one per page
+	names := []string{"group-1", "group-2", "group"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		groups := make([]interface{}, 0)
+		if offset < len(names) {
+			groups = append(groups, map[string]interface{}{"group_id": names[offset]})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"groups": groups, "total": len(names)})
+	}))
+	defer server.Close()
+
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{HTTPClient: *server.Client(), ProjectID: "project"},
+		Endpoint:       server.URL + "/",
+	}
+
+	group, err := getKafkaConsumerGroup(client, "instance", "group")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := group.(map[string]interface{})["group_id"]; got != "group" {
+		t.Errorf("expected the group on the last page, got %v", got)
+	}
+
+	if _, err = getKafkaConsumerGroup(client, "instance", "group-3"); err == nil {
+		t.Error("expected a 404 error for a missing group")
+	} else if _, ok := err.(golangsdk.ErrDefault404); !ok {
+		t.Errorf("expected a 404 error for a missing group, got %s", err)
+	}
+}
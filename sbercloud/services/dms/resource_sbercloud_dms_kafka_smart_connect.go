@@ -0,0 +1,221 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/dms/v2/kafka/instances"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	kafkaSmartConnectEnableHttpUrl  = "v2/{project_id}/instances/{instance_id}/connector"
+	kafkaSmartConnectDisableHttpUrl = "v2/{project_id}/kafka/instances/{instance_id}/delete-connector"
+)
+
+// ResourceDmsKafkaSmartConnect enables the Smart Connect of the Kafka instance, the Smart Connect tasks are running
+// on the connector nodes. The resource ID is the connector ID.
+func ResourceDmsKafkaSmartConnect() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsKafkaSmartConnectCreate,
+		ReadContext:   resourceDmsKafkaSmartConnectRead,
+		DeleteContext: resourceDmsKafkaSmartConnectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsKafkaSmartConnectImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      2,
+				ValidateFunc: validation.IntAtLeast(2),
+			},
+			"bandwidth": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// kafkaSmartConnectRefreshFunc reports COMPLETED when the instance is running and the state of the Smart Connect
+// is the expected one.
+func kafkaSmartConnectRefreshFunc(client *golangsdk.ServiceClient, instanceId string,
+	enabled bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := instances.Get(client, instanceId).Extract()
+		if err != nil {
+			return nil, "ERROR", err
+		}
+		if instance.Status == "RUNNING" && instance.ConnectorEnalbe == enabled {
+			return instance, "COMPLETED", nil
+		}
+		if strings.Contains(instance.Status, "FAILED") {
+			return instance, "ERROR", fmt.Errorf("unexpected status of the DMS kafka instance: %s", instance.Status)
+		}
+		return instance, "PENDING", nil
+	}
+}
+
+func waitForKafkaSmartConnect(ctx context.Context, client *golangsdk.ServiceClient, instanceId string, enabled bool,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      kafkaSmartConnectRefreshFunc(client, instanceId, enabled),
+		Timeout:      timeout,
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceDmsKafkaSmartConnectCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody: utils.RemoveNil(map[string]interface{}{
+			"node_cnt":      strconv.Itoa(d.Get("node_count").(int)),
+			"specification": utils.ValueIngoreEmpty(d.Get("bandwidth")),
+		}),
+	}
+	createPath := buildInstancePath(client, kafkaSmartConnectEnableHttpUrl, instanceId)
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error enabling Smart Connect of the DMS kafka instance (%s): %s", instanceId, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	connectorId := utils.PathSearch("connector_id", respBody, "").(string)
+	if connectorId == "" {
+		return diag.Errorf("unable to find the connector ID of the DMS kafka instance (%s) in the API response",
+			instanceId)
+	}
+	d.SetId(connectorId)
+
+	err = waitForKafkaSmartConnect(ctx, client, instanceId, true, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for Smart Connect of the DMS kafka instance (%s) to be enabled: %s",
+			instanceId, err)
+	}
+	return resourceDmsKafkaSmartConnectRead(ctx, d, meta)
+}
+
+func resourceDmsKafkaSmartConnectRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DmsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	instance, err := instances.Get(client, instanceId).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DMS kafka instance")
+	}
+	if !instance.ConnectorEnalbe || instance.ConnectorID != d.Id() {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving DMS kafka Smart Connect")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", instanceId),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DMS kafka Smart Connect fields: %s", err)
+	}
+	return nil
+}
+
+func resourceDmsKafkaSmartConnectDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+		JSONBody:         map[string]interface{}{},
+	}
+	deletePath := buildInstancePath(client, kafkaSmartConnectDisableHttpUrl, instanceId)
+	if _, err = client.Request("POST", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error disabling Smart Connect of the DMS kafka instance")
+	}
+
+	err = waitForKafkaSmartConnect(ctx, client, instanceId, false, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error waiting for Smart Connect of the DMS kafka instance (%s) to be disabled: %s",
+			instanceId, err)
+	}
+	return nil
+}
+
+// resourceDmsKafkaSmartConnectImport imports the Smart Connect by the instance ID, the resource ID is replaced with
+// the connector ID of the instance.
+func resourceDmsKafkaSmartConnectImport(_ context.Context, d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Id()
+	instance, err := instances.Get(client, instanceId).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving DMS kafka instance (%s): %s", instanceId, err)
+	}
+	if !instance.ConnectorEnalbe {
+		return nil, fmt.Errorf("Smart Connect of the DMS kafka instance (%s) is not enabled", instanceId)
+	}
+
+	d.SetId(instance.ConnectorID)
+	return []*schema.ResourceData{d}, d.Set("instance_id", instanceId)
+}
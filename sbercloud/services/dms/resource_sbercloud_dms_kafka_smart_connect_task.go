@@ -0,0 +1,565 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	kafkaSmartConnectTasksHttpUrl = "v2/{project_id}/instances/{instance_id}/connector/tasks"
+
+	// The source of the mirror tasks is the peer Kafka instance and the destination of the dump tasks is OBS.
+	smartConnectSourceKafka = "KAFKA_REPLICATOR_SOURCE"
+	smartConnectSinkObs     = "OBS_SINK"
+	smartConnectTypeNone    = "NONE"
+)
+
+// ResourceDmsKafkaSmartConnectTask manages a Smart Connect task of the Kafka instance, the task either mirrors the
+// messages between the Kafka instances or dumps the messages to OBS.
+func ResourceDmsKafkaSmartConnectTask() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsKafkaSmartConnectTaskCreate,
+		ReadContext:   resourceDmsKafkaSmartConnectTaskRead,
+		DeleteContext: resourceDmsKafkaSmartConnectTaskDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsKafkaSmartConnectTaskImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topics": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"topics", "topics_regex"},
+			},
+			"topics_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_task": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				Elem:         smartConnectSourceTaskSchema(),
+				ExactlyOneOf: []string{"source_task", "destination_task"},
+			},
+			"destination_task": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     smartConnectDestinationTaskSchema(),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func smartConnectSourceTaskSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"current_cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"peer_cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"pull", "push", "two-way"}, false),
+			},
+			"peer_instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"peer_instance_address": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"security_protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"PLAINTEXT", "SASL_SSL", "SASL_PLAINTEXT",
+				}, false),
+			},
+			"sasl_mechanism": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PLAIN", "SCRAM-SHA-512"}, false),
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"sync_consumer_offsets_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"replication_factor": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"task_num": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"rename_topic_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"provenance_header_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"consumer_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"latest", "earliest"}, false),
+			},
+			"compression_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"none", "gzip", "snappy", "lz4", "zstd",
+				}, false),
+			},
+			"topics_mapping": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func smartConnectDestinationTaskSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"consumer_strategy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"latest", "earliest"}, false),
+			},
+			"deliver_time_interval": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(30, 900),
+			},
+			"access_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"secret_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"obs_bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"obs_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"destination_file_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "TEXT",
+			},
+			"partition_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"record_delimiter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"store_keys": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func buildSmartConnectSourceTask(rawParams []interface{}) map[string]interface{} {
+	if len(rawParams) == 0 {
+		return nil
+	}
+	params, ok := rawParams[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	addresses := utils.ExpandToStringList(params["peer_instance_address"].([]interface{}))
+	topicsMapping := utils.ExpandToStringList(params["topics_mapping"].([]interface{}))
+	return utils.RemoveNil(map[string]interface{}{
+		"current_cluster_name":          params["current_cluster_name"],
+		"cluster_name":                  params["peer_cluster_name"],
+		"direction":                     params["direction"],
+		"instance_id":                   utils.ValueIngoreEmpty(params["peer_instance_id"]),
+		"bootstrap_servers":             utils.ValueIngoreEmpty(strings.Join(addresses, ",")),
+		"security_protocol":             utils.ValueIngoreEmpty(params["security_protocol"]),
+		"sasl_mechanism":                utils.ValueIngoreEmpty(params["sasl_mechanism"]),
+		"user_name":                     utils.ValueIngoreEmpty(params["user_name"]),
+		"password":                      utils.ValueIngoreEmpty(params["password"]),
+		"sync_consumer_offsets_enabled": params["sync_consumer_offsets_enabled"],
+		"replication_factor":            utils.ValueIngoreEmpty(params["replication_factor"]),
+		"task_num":                      utils.ValueIngoreEmpty(params["task_num"]),
+		"rename_topic_enabled":          params["rename_topic_enabled"],
+		"provenance_header_enabled":     params["provenance_header_enabled"],
+		"consumer_strategy":             utils.ValueIngoreEmpty(params["consumer_strategy"]),
+		"compression_type":              utils.ValueIngoreEmpty(params["compression_type"]),
+		"topics_mapping":                utils.ValueIngoreEmpty(strings.Join(topicsMapping, ",")),
+	})
+}
+
+func buildSmartConnectDestinationTask(rawParams []interface{}) map[string]interface{} {
+	if len(rawParams) == 0 {
+		return nil
+	}
+	params, ok := rawParams[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	return utils.RemoveNil(map[string]interface{}{
+		"consumer_strategy":     params["consumer_strategy"],
+		"deliver_time_interval": params["deliver_time_interval"],
+		"access_key":            params["access_key"],
+		"secret_key":            params["secret_key"],
+		"obs_bucket_name":       params["obs_bucket_name"],
+		"obs_path":              utils.ValueIngoreEmpty(params["obs_path"]),
+		"destination_file_type": params["destination_file_type"],
+		"partition_format":      utils.ValueIngoreEmpty(params["partition_format"]),
+		"record_delimiter":      utils.ValueIngoreEmpty(params["record_delimiter"]),
+		"store_keys":            params["store_keys"],
+	})
+}
+
+func buildSmartConnectTaskBodyParams(d *schema.ResourceData) map[string]interface{} {
+	topics := utils.ExpandToStringList(d.Get("topics").([]interface{}))
+	params := map[string]interface{}{
+		"task_name":    d.Get("name"),
+		"topics":       utils.ValueIngoreEmpty(strings.Join(topics, ",")),
+		"topics_regex": utils.ValueIngoreEmpty(d.Get("topics_regex")),
+		"source_type":  smartConnectTypeNone,
+		"sink_type":    smartConnectTypeNone,
+	}
+	if sourceTask := buildSmartConnectSourceTask(d.Get("source_task").([]interface{})); sourceTask != nil {
+		params["source_type"] = smartConnectSourceKafka
+		params["source_task"] = sourceTask
+	}
+	if sinkTask := buildSmartConnectDestinationTask(d.Get("destination_task").([]interface{})); sinkTask != nil {
+		params["sink_type"] = smartConnectSinkObs
+		params["sink_task"] = sinkTask
+	}
+	return utils.RemoveNil(params)
+}
+
+func buildSmartConnectTaskPath(client *golangsdk.ServiceClient, instanceId string, parts ...string) string {
+	path := buildInstancePath(client, kafkaSmartConnectTasksHttpUrl, instanceId)
+	return strings.Join(append([]string{path}, parts...), "/")
+}
+
+func smartConnectTaskStatusRefreshFunc(client *golangsdk.ServiceClient, instanceId,
+	taskId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes:          []int{200},
+		}
+		resp, err := client.Request("GET", buildSmartConnectTaskPath(client, instanceId, taskId), &getOpt)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+
+		switch status := utils.PathSearch("status", respBody, "").(string); status {
+		case "RUNNING":
+			return respBody, "COMPLETED", nil
+		case "ERROR", "FAILED":
+			return respBody, "ERROR", fmt.Errorf("unexpected status of the Smart Connect task: %s", status)
+		}
+		return respBody, "PENDING", nil
+	}
+}
+
+func resourceDmsKafkaSmartConnectTaskCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody:         buildSmartConnectTaskBodyParams(d),
+	}
+	resp, err := client.Request("POST", buildSmartConnectTaskPath(client, instanceId), &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating DMS kafka Smart Connect task: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	taskId := utils.PathSearch("id", respBody, "").(string)
+	if taskId == "" {
+		return diag.Errorf("unable to find the DMS kafka Smart Connect task ID in the API response")
+	}
+	d.SetId(taskId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      smartConnectTaskStatusRefreshFunc(client, instanceId, taskId),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DMS kafka Smart Connect task (%s) to be running: %s", taskId, err)
+	}
+	return resourceDmsKafkaSmartConnectTaskRead(ctx, d, meta)
+}
+
+// flattenSmartConnectSourceTask flattens the source task, the password is not returned and is kept as configured.
+func flattenSmartConnectSourceTask(sourceTask interface{}, password interface{}) []map[string]interface{} {
+	if sourceTask == nil {
+		return nil
+	}
+
+	splitList := func(expression string) []string {
+		value := utils.PathSearch(expression, sourceTask, "").(string)
+		if value == "" {
+			return nil
+		}
+		return strings.Split(value, ",")
+	}
+	return []map[string]interface{}{
+		{
+			"current_cluster_name":          utils.PathSearch("current_cluster_name", sourceTask, nil),
+			"peer_cluster_name":             utils.PathSearch("cluster_name", sourceTask, nil),
+			"direction":                     utils.PathSearch("direction", sourceTask, nil),
+			"peer_instance_id":              utils.PathSearch("instance_id", sourceTask, nil),
+			"peer_instance_address":         splitList("bootstrap_servers"),
+			"security_protocol":             utils.PathSearch("security_protocol", sourceTask, nil),
+			"sasl_mechanism":                utils.PathSearch("sasl_mechanism", sourceTask, nil),
+			"user_name":                     utils.PathSearch("user_name", sourceTask, nil),
+			"password":                      password,
+			"sync_consumer_offsets_enabled": utils.PathSearch("sync_consumer_offsets_enabled", sourceTask, nil),
+			"replication_factor":            utils.PathSearch("replication_factor", sourceTask, nil),
+			"task_num":                      utils.PathSearch("task_num", sourceTask, nil),
+			"rename_topic_enabled":          utils.PathSearch("rename_topic_enabled", sourceTask, nil),
+			"provenance_header_enabled":     utils.PathSearch("provenance_header_enabled", sourceTask, nil),
+			"consumer_strategy":             utils.PathSearch("consumer_strategy", sourceTask, nil),
+			"compression_type":              utils.PathSearch("compression_type", sourceTask, nil),
+			"topics_mapping":                splitList("topics_mapping"),
+		},
+	}
+}
+
+// flattenSmartConnectDestinationTask flattens the destination task, the access key and the secret key are not
+// returned and are kept as configured.
+func flattenSmartConnectDestinationTask(sinkTask, accessKey, secretKey interface{}) []map[string]interface{} {
+	if sinkTask == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"consumer_strategy":     utils.PathSearch("consumer_strategy", sinkTask, nil),
+			"deliver_time_interval": utils.PathSearch("deliver_time_interval", sinkTask, nil),
+			"access_key":            accessKey,
+			"secret_key":            secretKey,
+			"obs_bucket_name":       utils.PathSearch("obs_bucket_name", sinkTask, nil),
+			"obs_path":              utils.PathSearch("obs_path", sinkTask, nil),
+			"destination_file_type": utils.PathSearch("destination_file_type", sinkTask, nil),
+			"partition_format":      utils.PathSearch("partition_format", sinkTask, nil),
+			"record_delimiter":      utils.PathSearch("record_delimiter", sinkTask, nil),
+			"store_keys":            utils.PathSearch("store_keys", sinkTask, nil),
+		},
+	}
+}
+
+func resourceDmsKafkaSmartConnectTaskRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DmsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", buildSmartConnectTaskPath(client, d.Get("instance_id").(string), d.Id()),
+		&getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DMS kafka Smart Connect task")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var topics []string
+	if rawTopics := utils.PathSearch("topics", respBody, "").(string); rawTopics != "" {
+		topics = strings.Split(rawTopics, ",")
+	}
+	sourceTask := utils.PathSearch("source_task", respBody, nil)
+	if utils.PathSearch("source_type", respBody, "") != smartConnectSourceKafka {
+		sourceTask = nil
+	}
+	sinkTask := utils.PathSearch("sink_task", respBody, nil)
+	if utils.PathSearch("sink_type", respBody, "") != smartConnectSinkObs {
+		sinkTask = nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("task_name", respBody, nil)),
+		d.Set("topics", topics),
+		d.Set("topics_regex", utils.PathSearch("topics_regex", respBody, nil)),
+		d.Set("source_task", flattenSmartConnectSourceTask(sourceTask, d.Get("source_task.0.password"))),
+		d.Set("destination_task", flattenSmartConnectDestinationTask(sinkTask,
+			d.Get("destination_task.0.access_key"), d.Get("destination_task.0.secret_key"))),
+		d.Set("status", utils.PathSearch("status", respBody, nil)),
+		d.Set("created_at", utils.FormatTimeStampRFC3339(
+			int64(utils.PathSearch("create_time", respBody, float64(0)).(float64))/1000, false)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DMS kafka Smart Connect task fields: %s", err)
+	}
+	return nil
+}
+
+func resourceDmsKafkaSmartConnectTaskDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+	}
+	_, err = client.Request("DELETE", buildSmartConnectTaskPath(client, d.Get("instance_id").(string), d.Id()),
+		&deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DMS kafka Smart Connect task")
+	}
+	return nil
+}
+
+func resourceDmsKafkaSmartConnectTaskImport(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <instance_id>/<task_id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
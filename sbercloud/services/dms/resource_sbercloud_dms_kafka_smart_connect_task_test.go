@@ -0,0 +1,119 @@
+package dms
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildSmartConnectSourceTask(t *testing.T) {
+	rawParams := []interface{}{
+		map[string]interface{}{
+			"current_cluster_name":          "A",
+			"peer_cluster_name":             "B",
+			"direction":                     "pull",
+			"peer_instance_id":              "",
+			"peer_instance_address":         []interface{}{"192.168.0.1:9092", "192.168.0.2:9092"},
+			"security_protocol":             "SASL_SSL",
+			"sasl_mechanism":                "PLAIN",
+			"user_name":                     "user",
+			"password":                      "Test@123",
+			"sync_consumer_offsets_enabled": true,
+			"replication_factor":            0,
+			"task_num":                      2,
+			"rename_topic_enabled":          false,
+			"provenance_header_enabled":     false,
+			"consumer_strategy":             "earliest",
+			"compression_type":              "",
+			"topics_mapping":                []interface{}{"topic-a:topic-b", "topic-c:topic-d"},
+		},
+	}
+	expected := map[string]interface{}{
+		"current_cluster_name":          "A",
+		"cluster_name":                  "B",
+		"direction":                     "pull",
+		"bootstrap_servers":             "192.168.0.1:9092,192.168.0.2:9092",
+		"security_protocol":             "SASL_SSL",
+		"sasl_mechanism":                "PLAIN",
+		"user_name":                     "user",
+		"password":                      "Test@123",
+		"sync_consumer_offsets_enabled": true,
+		"task_num":                      2,
+		"rename_topic_enabled":          false,
+		"provenance_header_enabled":     false,
+		"consumer_strategy":             "earliest",
+		"topics_mapping":                "topic-a:topic-b,topic-c:topic-d",
+	}
+
+	if result := buildSmartConnectSourceTask(rawParams); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+	if result := buildSmartConnectSourceTask(nil); result != nil {
+		t.Errorf("expected nil without the source task, got %v", result)
+	}
+}
+
+func TestBuildSmartConnectDestinationTask(t *testing.T) {
+	rawParams := []interface{}{
+		map[string]interface{}{
+			"consumer_strategy":     "latest",
+			"deliver_time_interval": 300,
+			"access_key":            "AK",
+			"secret_key":            "SK",
+			"obs_bucket_name":       "bucket",
+			"obs_path":              "",
+			"destination_file_type": "TEXT",
+			"partition_format":      "yyyy/MM/dd/HH/mm",
+			"record_delimiter":      "",
+			"store_keys":            false,
+		},
+	}
+	expected := map[string]interface{}{
+		"consumer_strategy":     "latest",
+		"deliver_time_interval": 300,
+		"access_key":            "AK",
+		"secret_key":            "SK",
+		"obs_bucket_name":       "bucket",
+		"destination_file_type": "TEXT",
+		"partition_format":      "yyyy/MM/dd/HH/mm",
+		"store_keys":            false,
+	}
+
+	if result := buildSmartConnectDestinationTask(rawParams); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestFlattenSmartConnectSourceTask(t *testing.T) {
+	sourceTask := map[string]interface{}{
+		"current_cluster_name": "A",
+		"cluster_name":         "B",
+		"direction":            "two-way",
+		"instance_id":          "instance-1",
+		"bootstrap_servers":    "",
+		"rename_topic_enabled": true,
+		"replication_factor":   float64(3),
+		"topics_mapping":       "topic-a:topic-b",
+	}
+
+	result := flattenSmartConnectSourceTask(sourceTask, "Test@123")
+	if len(result) != 1 {
+		t.Fatalf("expected one source task, got %v", result)
+	}
+	checks := map[string]interface{}{
+		"peer_cluster_name":     "B",
+		"peer_instance_id":      "instance-1",
+		"peer_instance_address": []string(nil),
+		"password":              "Test@123",
+		"replication_factor":    float64(3),
+		"topics_mapping":        []string{"topic-a:topic-b"},
+	}
+	for key, expected := range checks {
+		if !reflect.DeepEqual(result[0][key], expected) {
+			t.Errorf("expected %s to be %v, got %v", key, expected, result[0][key])
+		}
+	}
+
+	if result = flattenSmartConnectSourceTask(nil, nil); result != nil {
+		t.Errorf("expected nil without the source task, got %v", result)
+	}
+}
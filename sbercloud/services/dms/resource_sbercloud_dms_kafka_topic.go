@@ -0,0 +1,36 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dms"
+)
+
+// ResourceDmsKafkaTopic extends the topic resource of huaweicloud with the validation of the partitions. The
+// partitions of the topic can be expanded in place, but they are never reduced by Kafka.
+func ResourceDmsKafkaTopic() *schema.Resource {
+	resource := dms.ResourceDmsKafkaTopic()
+	resource.Schema["partitions"].ValidateFunc = validation.IntBetween(1, 100)
+	resource.CustomizeDiff = resourceDmsKafkaTopicCustomizeDiff
+	return resource
+}
+
+func resourceDmsKafkaTopicCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("partitions") {
+		return nil
+	}
+	oldVal, newVal := d.GetChange("partitions")
+	return checkTopicPartitions(oldVal.(int), newVal.(int))
+}
+
+func checkTopicPartitions(oldPartitions, newPartitions int) error {
+	if newPartitions < oldPartitions {
+		return fmt.Errorf("the partitions of the topic can only be increased, from %d to %d is not allowed",
+			oldPartitions, newPartitions)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package dms
+
+import "testing"
+
+func TestCheckTopicPartitions(t *testing.T) {
+	testCases := []struct {
+		oldPartitions, newPartitions int
+		valid                        bool
+	}{
+		{3, 6, true},
+		{6, 6, true},
+		{6, 3, false},
+	}
+
+	for _, tc := range testCases {
+		if err := checkTopicPartitions(tc.oldPartitions, tc.newPartitions); (err == nil) != tc.valid {
+			t.Errorf("changing the partitions from %d to %d, expected valid to be %t, got the error: %v",
+				tc.oldPartitions, tc.newPartitions, tc.valid, err)
+		}
+	}
+}
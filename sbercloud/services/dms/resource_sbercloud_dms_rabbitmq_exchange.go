@@ -0,0 +1,175 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	rabbitmqExchangesHttpUrl = "v2/{project_id}/rabbitmq/instances/{instance_id}/vhosts/{vhost}/exchanges"
+	rabbitmqExchangeIdFormat = "<instance_id>,<vhost>,<name>"
+)
+
+// ResourceDmsRabbitmqExchange manages an exchange in the vhost of the RabbitMQ instance, the ID is formatted as
+// <instance_id>,<vhost>,<name>.
+func ResourceDmsRabbitmqExchange() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRabbitmqExchangeCreate,
+		ReadContext:   resourceDmsRabbitmqExchangeRead,
+		DeleteContext: resourceDmsRabbitmqExchangeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vhost": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"direct", "fanout", "topic", "headers",
+				}, false),
+			},
+			"durable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"auto_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"internal": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDmsRabbitmqExchangeCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	vhost := d.Get("vhost").(string)
+	name := d.Get("name").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody: map[string]interface{}{
+			"name":        name,
+			"type":        d.Get("type"),
+			"durable":     d.Get("durable"),
+			"auto_delete": d.Get("auto_delete"),
+			"internal":    d.Get("internal"),
+		},
+	}
+	createPath := buildRabbitmqVhostPath(client, rabbitmqExchangesHttpUrl, instanceId, vhost)
+	if _, err = client.Request("POST", createPath, &createOpt); err != nil {
+		return diag.Errorf("error creating DMS rabbitmq exchange: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s,%s", instanceId, vhost, name))
+	return resourceDmsRabbitmqExchangeRead(ctx, d, meta)
+}
+
+func resourceDmsRabbitmqExchangeRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DmsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	parts, err := parseRabbitmqResourceId(d.Id(), rabbitmqExchangeIdFormat)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceId, vhost, name := parts[0], parts[1], parts[2]
+	exchanges, err := listRabbitmqItems(client,
+		buildRabbitmqVhostPath(client, rabbitmqExchangesHttpUrl, instanceId, vhost))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DMS rabbitmq exchange")
+	}
+	exchange := utils.PathSearch(fmt.Sprintf("[?name=='%s']|[0]", name), exchanges, nil)
+	if exchange == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving DMS rabbitmq exchange")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", instanceId),
+		d.Set("vhost", vhost),
+		d.Set("name", name),
+		d.Set("type", utils.PathSearch("type", exchange, nil)),
+		d.Set("durable", utils.PathSearch("durable", exchange, false)),
+		d.Set("auto_delete", utils.PathSearch("auto_delete", exchange, false)),
+		d.Set("internal", utils.PathSearch("internal", exchange, false)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DMS rabbitmq exchange fields: %s", err)
+	}
+	return nil
+}
+
+func resourceDmsRabbitmqExchangeDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+		JSONBody: map[string]interface{}{
+			"name": []string{d.Get("name").(string)},
+		},
+	}
+	deletePath := buildRabbitmqVhostPath(client, rabbitmqExchangesHttpUrl, d.Get("instance_id").(string),
+		d.Get("vhost").(string))
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DMS rabbitmq exchange")
+	}
+	return nil
+}
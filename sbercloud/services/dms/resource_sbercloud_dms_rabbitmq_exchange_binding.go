@@ -0,0 +1,194 @@
+package dms
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	rabbitmqBindingsHttpUrl = "v2/{project_id}/rabbitmq/instances/{instance_id}/vhosts/{vhost}/exchanges/{exchange}/" +
+		"binding"
+	rabbitmqUnbindHttpUrl = "v2/{project_id}/rabbitmq/instances/{instance_id}/vhosts/{vhost}/exchanges/{exchange}/" +
+		"destination-type/{destination_type}/destination/{destination}/properties-key/{properties_key}/unbind"
+	rabbitmqBindingIdFormat = "<instance_id>,<vhost>,<exchange>,<destination_type>,<destination>,<routing_key>"
+)
+
+// ResourceDmsRabbitmqExchangeBinding binds a queue or an exchange to the exchange of the RabbitMQ instance, the ID is
+// formatted as <instance_id>,<vhost>,<exchange>,<destination_type>,<destination>,<routing_key>.
+func ResourceDmsRabbitmqExchangeBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRabbitmqExchangeBindingCreate,
+		ReadContext:   resourceDmsRabbitmqExchangeBindingRead,
+		DeleteContext: resourceDmsRabbitmqExchangeBindingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vhost": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"exchange": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Queue", "Exchange"}, false),
+			},
+			"destination": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"routing_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"properties_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// filterRabbitmqBinding picks the binding from the bindings of the exchange, the destination types are returned in
+// lower case.
+func filterRabbitmqBinding(bindings []interface{}, destinationType, destination, routingKey string) interface{} {
+	for _, binding := range bindings {
+		if strings.EqualFold(utils.PathSearch("destination_type", binding, "").(string), destinationType) &&
+			utils.PathSearch("destination", binding, "").(string) == destination &&
+			utils.PathSearch("routing_key", binding, "").(string) == routingKey {
+			return binding
+		}
+	}
+	return nil
+}
+
+func buildRabbitmqBindingsPath(client *golangsdk.ServiceClient, instanceId, vhost, exchange string) string {
+	path := buildRabbitmqVhostPath(client, rabbitmqBindingsHttpUrl, instanceId, vhost)
+	return strings.ReplaceAll(path, "{exchange}", encodeRabbitmqPathParam(exchange))
+}
+
+func resourceDmsRabbitmqExchangeBindingCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	vhost := d.Get("vhost").(string)
+	exchange := d.Get("exchange").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody: map[string]interface{}{
+			"destination_type": d.Get("destination_type"),
+			"destination":      d.Get("destination"),
+			"routing_key":      d.Get("routing_key"),
+		},
+	}
+	createPath := buildRabbitmqBindingsPath(client, instanceId, vhost, exchange)
+	if _, err = client.Request("POST", createPath, &createOpt); err != nil {
+		return diag.Errorf("error creating DMS rabbitmq exchange binding: %s", err)
+	}
+
+	d.SetId(strings.Join([]string{instanceId, vhost, exchange, d.Get("destination_type").(string),
+		d.Get("destination").(string), d.Get("routing_key").(string)}, ","))
+	return resourceDmsRabbitmqExchangeBindingRead(ctx, d, meta)
+}
+
+func resourceDmsRabbitmqExchangeBindingRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DmsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	parts, err := parseRabbitmqResourceId(d.Id(), rabbitmqBindingIdFormat)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceId, vhost, exchange := parts[0], parts[1], parts[2]
+	bindings, err := listRabbitmqItems(client, buildRabbitmqBindingsPath(client, instanceId, vhost, exchange))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DMS rabbitmq exchange binding")
+	}
+	binding := filterRabbitmqBinding(bindings, parts[3], parts[4], parts[5])
+	if binding == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving DMS rabbitmq exchange binding")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", instanceId),
+		d.Set("vhost", vhost),
+		d.Set("exchange", exchange),
+		d.Set("destination_type", parts[3]),
+		d.Set("destination", parts[4]),
+		d.Set("routing_key", parts[5]),
+		d.Set("properties_key", utils.PathSearch("properties_key", binding, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DMS rabbitmq exchange binding fields: %s", err)
+	}
+	return nil
+}
+
+func resourceDmsRabbitmqExchangeBindingDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	deletePath := buildRabbitmqVhostPath(client, rabbitmqUnbindHttpUrl, d.Get("instance_id").(string),
+		d.Get("vhost").(string))
+	deletePath = strings.NewReplacer(
+		"{exchange}", encodeRabbitmqPathParam(d.Get("exchange").(string)),
+		"{destination_type}", d.Get("destination_type").(string),
+		"{destination}", encodeRabbitmqPathParam(d.Get("destination").(string)),
+		"{properties_key}", encodeRabbitmqPathParam(d.Get("properties_key").(string)),
+	).Replace(deletePath)
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+	}
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DMS rabbitmq exchange binding")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package dms
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterRabbitmqBinding(t *testing.T) {
+	bindings := []interface{}{
+		map[string]interface{}{
+			"destination_type": "queue",
+			"destination":      "queue-a",
+			"routing_key":      "",
+			"properties_key":   "~",
+		},
+		map[string]interface{}{
+			"destination_type": "queue",
+			"destination":      "queue-a",
+			"routing_key":      "order.created",
+			"properties_key":   "order.created",
+		},
+		map[string]interface{}{
+			"destination_type": "exchange",
+			"destination":      "queue-a",
+			"routing_key":      "order.created",
+			"properties_key":   "order.created",
+		},
+	}
+
+	testCases := []struct {
+		destinationType, destination, routingKey string
+		expected                                 interface{}
+	}{
+		{"Queue", "queue-a", "", bindings[0]},
+		{"Queue", "queue-a", "order.created", bindings[1]},
+		{"Exchange", "queue-a", "order.created", bindings[2]},
+		{"Queue", "queue-b", "", nil},
+		{"Exchange", "queue-a", "", nil},
+	}
+
+	for _, tc := range testCases {
+		got := filterRabbitmqBinding(bindings, tc.destinationType, tc.destination, tc.routingKey)
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("filtering the binding (%s, %s, %q), expected %v, got %v", tc.destinationType, tc.destination,
+				tc.routingKey, tc.expected, got)
+		}
+	}
+}
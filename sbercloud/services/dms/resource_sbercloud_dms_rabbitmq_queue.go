@@ -0,0 +1,212 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	rabbitmqQueuesHttpUrl = "v2/{project_id}/rabbitmq/instances/{instance_id}/vhosts/{vhost}/queues"
+	rabbitmqQueueHttpUrl  = "v2/{project_id}/rabbitmq/instances/{instance_id}/vhosts/{vhost}/queues/{queue}"
+	rabbitmqQueueIdFormat = "<instance_id>,<vhost>,<name>"
+)
+
+// ResourceDmsRabbitmqQueue manages a queue in the vhost of the RabbitMQ instance, the ID is formatted as
+// <instance_id>,<vhost>,<name>. The arguments of the queue, such as the TTL and the dead letter exchange, can not be
+// changed after the queue is declared, so all the arguments are ForceNew.
+func ResourceDmsRabbitmqQueue() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRabbitmqQueueCreate,
+		ReadContext:   resourceDmsRabbitmqQueueRead,
+		DeleteContext: resourceDmsRabbitmqQueueDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vhost": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"durable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"auto_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"message_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"dead_letter_exchange": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"dead_letter_routing_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"dead_letter_exchange"},
+			},
+			"lazy_mode": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"messages": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"consumers": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildRabbitmqQueueBodyParams(d *schema.ResourceData) map[string]interface{} {
+	params := map[string]interface{}{
+		"name":                    d.Get("name"),
+		"durable":                 d.Get("durable"),
+		"auto_delete":             d.Get("auto_delete"),
+		"message_ttl":             utils.ValueIngoreEmpty(d.Get("message_ttl")),
+		"dead_letter_exchange":    utils.ValueIngoreEmpty(d.Get("dead_letter_exchange")),
+		"dead_letter_routing_key": utils.ValueIngoreEmpty(d.Get("dead_letter_routing_key")),
+		"lazy_mode":               nil,
+	}
+	if d.Get("lazy_mode").(bool) {
+		params["lazy_mode"] = "lazy"
+	}
+	return utils.RemoveNil(params)
+}
+
+func resourceDmsRabbitmqQueueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	vhost := d.Get("vhost").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody:         buildRabbitmqQueueBodyParams(d),
+	}
+	createPath := buildRabbitmqVhostPath(client, rabbitmqQueuesHttpUrl, instanceId, vhost)
+	if _, err = client.Request("POST", createPath, &createOpt); err != nil {
+		return diag.Errorf("error creating DMS rabbitmq queue: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s,%s", instanceId, vhost, d.Get("name")))
+	return resourceDmsRabbitmqQueueRead(ctx, d, meta)
+}
+
+func resourceDmsRabbitmqQueueRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DmsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	parts, err := parseRabbitmqResourceId(d.Id(), rabbitmqQueueIdFormat)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceId, vhost, name := parts[0], parts[1], parts[2]
+	getPath := buildRabbitmqVhostPath(client, rabbitmqQueueHttpUrl, instanceId, vhost)
+	getPath = strings.ReplaceAll(getPath, "{queue}", encodeRabbitmqPathParam(name))
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DMS rabbitmq queue")
+	}
+	queue, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The queue arguments are returned in the native format of RabbitMQ.
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", instanceId),
+		d.Set("vhost", vhost),
+		d.Set("name", name),
+		d.Set("durable", utils.PathSearch("durable", queue, false)),
+		d.Set("auto_delete", utils.PathSearch("auto_delete", queue, false)),
+		d.Set("message_ttl", utils.PathSearch(`arguments."x-message-ttl"`, queue, nil)),
+		d.Set("dead_letter_exchange", utils.PathSearch(`arguments."x-dead-letter-exchange"`, queue, nil)),
+		d.Set("dead_letter_routing_key", utils.PathSearch(`arguments."x-dead-letter-routing-key"`, queue, nil)),
+		d.Set("lazy_mode", utils.PathSearch(`arguments."x-queue-mode"`, queue, "") == "lazy"),
+		d.Set("messages", utils.PathSearch("messages", queue, nil)),
+		d.Set("consumers", utils.PathSearch("consumers", queue, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DMS rabbitmq queue fields: %s", err)
+	}
+	return nil
+}
+
+func resourceDmsRabbitmqQueueDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+		JSONBody: map[string]interface{}{
+			"name": []string{d.Get("name").(string)},
+		},
+	}
+	deletePath := buildRabbitmqVhostPath(client, rabbitmqQueuesHttpUrl, d.Get("instance_id").(string),
+		d.Get("vhost").(string))
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DMS rabbitmq queue")
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	rabbitmqUsersHttpUrl = "v2/{project_id}/instances/{instance_id}/users"
+	rabbitmqUserHttpUrl  = "v2/{project_id}/instances/{instance_id}/users/{user_name}"
+	rabbitmqUserIdFormat = "<instance_id>,<access_key>"
+)
+
+// ResourceDmsRabbitmqUser manages a user of the RabbitMQ instance and the permissions of the user in the vhosts, the
+// ID is formatted as <instance_id>,<access_key>.
+func ResourceDmsRabbitmqUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRabbitmqUserCreate,
+		ReadContext:   resourceDmsRabbitmqUserRead,
+		UpdateContext: resourceDmsRabbitmqUserUpdate,
+		DeleteContext: resourceDmsRabbitmqUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"access_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"secret_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"vhosts": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vhost": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"conf": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"write": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"read": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildRabbitmqUserVhosts builds the permissions of the user, the conf, write and read are the regular expressions of
+// the resources which can be configured, written and read in the vhost.
+func buildRabbitmqUserVhosts(rawVhosts []interface{}) []map[string]interface{} {
+	vhosts := make([]map[string]interface{}, 0, len(rawVhosts))
+	for _, rawVhost := range rawVhosts {
+		vhost := rawVhost.(map[string]interface{})
+		vhosts = append(vhosts, map[string]interface{}{
+			"vhost": vhost["vhost"],
+			"conf":  vhost["conf"],
+			"write": vhost["write"],
+			"read":  vhost["read"],
+		})
+	}
+	return vhosts
+}
+
+func flattenRabbitmqUserVhosts(user interface{}) []map[string]interface{} {
+	rawVhosts := utils.PathSearch("vhosts", user, make([]interface{}, 0)).([]interface{})
+	vhosts := make([]map[string]interface{}, 0, len(rawVhosts))
+	for _, vhost := range rawVhosts {
+		vhosts = append(vhosts, map[string]interface{}{
+			"vhost": utils.PathSearch("vhost", vhost, nil),
+			"conf":  utils.PathSearch("conf", vhost, nil),
+			"write": utils.PathSearch("write", vhost, nil),
+			"read":  utils.PathSearch("read", vhost, nil),
+		})
+	}
+	return vhosts
+}
+
+func buildRabbitmqUserPath(client *golangsdk.ServiceClient, instanceId, accessKey string) string {
+	path := buildInstancePath(client, rabbitmqUserHttpUrl, instanceId)
+	return strings.ReplaceAll(path, "{user_name}", accessKey)
+}
+
+func resourceDmsRabbitmqUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	accessKey := d.Get("access_key").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody: map[string]interface{}{
+			"access_key": accessKey,
+			"secret_key": d.Get("secret_key"),
+			"vhosts":     buildRabbitmqUserVhosts(d.Get("vhosts").(*schema.Set).List()),
+		},
+	}
+	createPath := buildInstancePath(client, rabbitmqUsersHttpUrl, instanceId)
+	if _, err = client.Request("POST", createPath, &createOpt); err != nil {
+		return diag.Errorf("error creating DMS rabbitmq user: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", instanceId, accessKey))
+	return resourceDmsRabbitmqUserRead(ctx, d, meta)
+}
+
+func resourceDmsRabbitmqUserRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DmsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	parts, err := parseRabbitmqResourceId(d.Id(), rabbitmqUserIdFormat)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceId, accessKey := parts[0], parts[1]
+	users, err := listRabbitmqItems(client, buildInstancePath(client, rabbitmqUsersHttpUrl, instanceId))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DMS rabbitmq user")
+	}
+	user := utils.PathSearch(fmt.Sprintf("[?access_key=='%s']|[0]", accessKey), users, nil)
+	if user == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving DMS rabbitmq user")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", instanceId),
+		d.Set("access_key", accessKey),
+		d.Set("vhosts", flattenRabbitmqUserVhosts(user)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DMS rabbitmq user fields: %s", err)
+	}
+	return nil
+}
+
+func resourceDmsRabbitmqUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	params := map[string]interface{}{
+		"vhosts": buildRabbitmqUserVhosts(d.Get("vhosts").(*schema.Set).List()),
+	}
+	if d.HasChange("secret_key") {
+		params["secret_key"] = d.Get("secret_key")
+	}
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+		JSONBody:         params,
+	}
+	updatePath := buildRabbitmqUserPath(client, d.Get("instance_id").(string), d.Get("access_key").(string))
+	if _, err = client.Request("PUT", updatePath, &updateOpt); err != nil {
+		return diag.Errorf("error updating DMS rabbitmq user (%s): %s", d.Id(), err)
+	}
+	return resourceDmsRabbitmqUserRead(ctx, d, meta)
+}
+
+func resourceDmsRabbitmqUserDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+	}
+	deletePath := buildRabbitmqUserPath(client, d.Get("instance_id").(string), d.Get("access_key").(string))
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DMS rabbitmq user")
+	}
+	return nil
+}
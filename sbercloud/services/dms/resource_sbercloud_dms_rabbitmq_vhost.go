@@ -0,0 +1,135 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	rabbitmqVhostsHttpUrl = "v2/{project_id}/rabbitmq/instances/{instance_id}/vhosts"
+	rabbitmqVhostIdFormat = "<instance_id>,<name>"
+)
+
+// ResourceDmsRabbitmqVhost manages a vhost of the RabbitMQ instance, the ID is formatted as <instance_id>,<name>.
+func ResourceDmsRabbitmqVhost() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRabbitmqVhostCreate,
+		ReadContext:   resourceDmsRabbitmqVhostRead,
+		DeleteContext: resourceDmsRabbitmqVhostDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tracing": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDmsRabbitmqVhostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody: map[string]interface{}{
+			"name": name,
+		},
+	}
+	createPath := buildInstancePath(client, rabbitmqVhostsHttpUrl, instanceId)
+	if _, err = client.Request("POST", createPath, &createOpt); err != nil {
+		return diag.Errorf("error creating DMS rabbitmq vhost: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", instanceId, name))
+	return resourceDmsRabbitmqVhostRead(ctx, d, meta)
+}
+
+func resourceDmsRabbitmqVhostRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DmsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	parts, err := parseRabbitmqResourceId(d.Id(), rabbitmqVhostIdFormat)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceId, name := parts[0], parts[1]
+	vhosts, err := listRabbitmqItems(client, buildInstancePath(client, rabbitmqVhostsHttpUrl, instanceId))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DMS rabbitmq vhost")
+	}
+	vhost := utils.PathSearch(fmt.Sprintf("[?name=='%s']|[0]", name), vhosts, nil)
+	if vhost == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving DMS rabbitmq vhost")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", instanceId),
+		d.Set("name", name),
+		d.Set("tracing", utils.PathSearch("tracing", vhost, false)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DMS rabbitmq vhost fields: %s", err)
+	}
+	return nil
+}
+
+func resourceDmsRabbitmqVhostDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+		JSONBody: map[string]interface{}{
+			"name": []string{d.Get("name").(string)},
+		},
+	}
+	deletePath := buildInstancePath(client, rabbitmqVhostsHttpUrl, d.Get("instance_id").(string))
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DMS rabbitmq vhost")
+	}
+	return nil
+}
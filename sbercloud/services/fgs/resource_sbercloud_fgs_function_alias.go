@@ -0,0 +1,205 @@
+package fgs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/chnsz/golangsdk/openstack/fgs/v2/aliases"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// ResourceFgsFunctionAlias manages an alias of the function versions. A part of the requests can be routed
+// to additional versions by weight, which is used for canary releases.
+func ResourceFgsFunctionAlias() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFgsFunctionAliasCreate,
+		ReadContext:   resourceFgsFunctionAliasRead,
+		UpdateContext: resourceFgsFunctionAliasUpdate,
+		DeleteContext: resourceFgsFunctionAliasDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"function_urn": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressQualifiedUrnDiffs,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"additional_version_weights": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+			},
+			"urn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildAdditionalVersionWeights(weights map[string]interface{}) map[string]interface{} {
+	if len(weights) == 0 {
+		return nil
+	}
+	return weights
+}
+
+// flattenAdditionalVersionWeights converts the weights to integers, the API may return them as numbers
+// or as numeric strings.
+func flattenAdditionalVersionWeights(weights map[string]interface{}) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(weights))
+	for version, weight := range weights {
+		switch w := weight.(type) {
+		case float64:
+			result[version] = int(w)
+		case string:
+			v, err := strconv.Atoi(w)
+			if err != nil {
+				return nil, fmt.Errorf("invalid weight of the version %s: %s", version, w)
+			}
+			result[version] = v
+		default:
+			return nil, fmt.Errorf("invalid weight type of the version %s: %T", version, weight)
+		}
+	}
+	return result, nil
+}
+
+func resourceFgsFunctionAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.FgsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph v2 client: %s", err)
+	}
+
+	functionUrn := unqualifiedFunctionUrn(d.Get("function_urn").(string))
+	opts := aliases.CreateOpts{
+		FunctionUrn:              functionUrn,
+		Name:                     d.Get("name").(string),
+		Version:                  d.Get("version").(string),
+		Description:              d.Get("description").(string),
+		AdditionalVersionWeights: buildAdditionalVersionWeights(d.Get("additional_version_weights").(map[string]interface{})),
+	}
+	alias, err := aliases.Create(client, opts)
+	if err != nil {
+		return diag.Errorf("error creating alias of the function (%s): %s", functionUrn, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", functionUrn, alias.Name))
+	return resourceFgsFunctionAliasRead(ctx, d, meta)
+}
+
+func resourceFgsFunctionAliasRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.FgsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph v2 client: %s", err)
+	}
+
+	functionUrn, aliasName, err := parseFunctionResourceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	alias, err := aliases.Get(client, functionUrn, aliasName)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving function alias")
+	}
+
+	weights, err := flattenAdditionalVersionWeights(alias.AdditionalVersionWeights)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", cfg.GetRegion(d)),
+		d.Set("function_urn", functionUrn),
+		d.Set("name", alias.Name),
+		d.Set("version", alias.Version),
+		d.Set("description", alias.Description),
+		d.Set("additional_version_weights", weights),
+		d.Set("urn", alias.AliasUrn),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting function alias fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFgsFunctionAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.FgsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph v2 client: %s", err)
+	}
+
+	functionUrn, aliasName, err := parseFunctionResourceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := aliases.UpdateOpts{
+		FunctionUrn:              functionUrn,
+		Name:                     aliasName,
+		Version:                  d.Get("version").(string),
+		Description:              d.Get("description").(string),
+		AdditionalVersionWeights: buildAdditionalVersionWeights(d.Get("additional_version_weights").(map[string]interface{})),
+	}
+	if _, err = aliases.Update(client, opts); err != nil {
+		return diag.Errorf("error updating function alias (%s): %s", d.Id(), err)
+	}
+
+	return resourceFgsFunctionAliasRead(ctx, d, meta)
+}
+
+func resourceFgsFunctionAliasDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.FgsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph v2 client: %s", err)
+	}
+
+	functionUrn, aliasName, err := parseFunctionResourceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = aliases.Delete(client, functionUrn, aliasName); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting function alias")
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package fgs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/fgs/v2/versions"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceFgsFunctionVersion publishes a version of the function code and configuration, the published
+// versions are immutable and are referenced by the aliases for canary releases.
+func ResourceFgsFunctionVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFgsFunctionVersionCreate,
+		ReadContext:   resourceFgsFunctionVersionRead,
+		DeleteContext: resourceFgsFunctionVersionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"function_urn": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressQualifiedUrnDiffs,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"urn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// unqualifiedFunctionUrn removes the version or alias from the function URN, which is formatted as
+// urn:fss:<region>:<project_id>:function:<app>:<name>[:<version>].
+func unqualifiedFunctionUrn(urn string) string {
+	parts := strings.Split(urn, ":")
+	if len(parts) > 7 {
+		return strings.Join(parts[:7], ":")
+	}
+	return urn
+}
+
+func suppressQualifiedUrnDiffs(_, old, new string, _ *schema.ResourceData) bool {
+	return unqualifiedFunctionUrn(old) == unqualifiedFunctionUrn(new)
+}
+
+func resourceFgsFunctionVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.FgsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph v2 client: %s", err)
+	}
+
+	functionUrn := unqualifiedFunctionUrn(d.Get("function_urn").(string))
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+		JSONBody: utils.RemoveNil(map[string]interface{}{
+			"version":     utils.ValueIngoreEmpty(d.Get("version")),
+			"description": utils.ValueIngoreEmpty(d.Get("description")),
+		}),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("fgs/functions", functionUrn, "versions"), &createOpt)
+	if err != nil {
+		return diag.Errorf("error publishing version of the function (%s): %s", functionUrn, err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	version := utils.PathSearch("version", respBody, "").(string)
+	if version == "" {
+		return diag.Errorf("unable to find the version in the API response")
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", functionUrn, version))
+	return resourceFgsFunctionVersionRead(ctx, d, meta)
+}
+
+func parseFunctionResourceId(id string) (functionUrn, name string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format, want '<function_urn>/<name>', but got '%s'", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceFgsFunctionVersionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.FgsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph v2 client: %s", err)
+	}
+
+	functionUrn, versionName, err := parseFunctionResourceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	versionList, err := versions.List(client, versions.ListOpts{FunctionUrn: functionUrn})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving function versions")
+	}
+
+	var version *versions.Version
+	for i := range versionList {
+		if versionList[i].Version == versionName {
+			version = &versionList[i]
+			break
+		}
+	}
+	if version == nil {
+		log.Printf("[WARN] the version %s of the function %s is gone", versionName, functionUrn)
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", cfg.GetRegion(d)),
+		d.Set("function_urn", functionUrn),
+		d.Set("version", version.Version),
+		d.Set("digest", version.Digest),
+		d.Set("urn", version.FuncUrn),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting function version fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFgsFunctionVersionDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.FgsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph v2 client: %s", err)
+	}
+
+	functionUrn, versionName, err := parseFunctionResourceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// deleting the function URN qualified with the version only deletes that version
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+	}
+	_, err = client.Request("DELETE", client.ServiceURL("fgs/functions", functionUrn+":"+versionName), &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting function version")
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package fgs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnqualifiedFunctionUrn(t *testing.T) {
+	const urn = "urn:fss:ru-moscow-1:project-id:function:default:test"
+
+	testCases := map[string]string{
+		urn:                   urn,
+		urn + ":latest":       urn,
+		urn + ":v20230801":    urn,
+		"invalid-urn":         "invalid-urn",
+		"urn:fss:ru-moscow-1": "urn:fss:ru-moscow-1",
+	}
+	for input, expected := range testCases {
+		if got := unqualifiedFunctionUrn(input); got != expected {
+			t.Errorf("unqualifiedFunctionUrn(%q): expected %q, got %q", input, expected, got)
+		}
+	}
+}
+
+func TestParseFunctionResourceId(t *testing.T) {
+	urn, name, err := parseFunctionResourceId("urn:fss:ru-moscow-1:project-id:function:default:test/v1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if urn != "urn:fss:ru-moscow-1:project-id:function:default:test" || name != "v1" {
+		t.Fatalf("unexpected result: %s, %s", urn, name)
+	}
+
+	for _, id := range []string{"", "urn", "urn/", "/v1"} {
+		if _, _, err := parseFunctionResourceId(id); err == nil {
+			t.Errorf("expected an error for the ID %q", id)
+		}
+	}
+}
+
+func TestFlattenAdditionalVersionWeights(t *testing.T) {
+	got, err := flattenAdditionalVersionWeights(map[string]interface{}{"v1": float64(10), "v2": "20"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := map[string]interface{}{"v1": 10, "v2": 20}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+
+	if _, err := flattenAdditionalVersionWeights(map[string]interface{}{"v1": "ten"}); err == nil {
+		t.Fatal("expected an error for the invalid weight")
+	}
+}
@@ -0,0 +1,363 @@
+package lts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const keywordsAlarmRuleHttpUrl = "v2/{project_id}/lts/alarms/keywords-alarm-rule"
+
+// ResourceLtsKeywordsAlarmRule manages an alarm rule which is triggered when the number of the log events
+// matching the keywords reaches the threshold within the search period.
+func ResourceLtsKeywordsAlarmRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLtsKeywordsAlarmRuleCreate,
+		ReadContext:   resourceLtsKeywordsAlarmRuleRead,
+		UpdateContext: resourceLtsKeywordsAlarmRuleUpdate,
+		DeleteContext: resourceLtsKeywordsAlarmRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"keywords_requests": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_group_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"log_stream_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"keywords": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"condition": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{">", ">=", "<", "<="}, false),
+						},
+						"number": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"search_time_range": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"search_time_range_unit": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "minute",
+							ValidateFunc: validation.StringInSlice([]string{"minute", "hour"}, false),
+						},
+					},
+				},
+			},
+			"frequency": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"CRON", "HOURLY", "DAILY", "WEEKLY", "FIXED_RATE",
+							}, false),
+						},
+						"cron_expression": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"hour_of_day": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"day_of_week": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 7),
+						},
+						"fixed_rate": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"fixed_rate_unit": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"minute", "hour"}, false),
+						},
+					},
+				},
+			},
+			"alarm_level": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Info", "Minor", "Major", "Critical"}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"trigger_condition_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+			"trigger_condition_frequency": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+		},
+	}
+}
+
+func buildKeywordsRequestsBodyParams(rawArray []interface{}) []map[string]interface{} {
+	requests := make([]map[string]interface{}, 0, len(rawArray))
+	for _, v := range rawArray {
+		raw, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		requests = append(requests, map[string]interface{}{
+			"log_group_id":           raw["log_group_id"],
+			"log_stream_id":          raw["log_stream_id"],
+			"keywords":               raw["keywords"],
+			"condition":              raw["condition"],
+			"number":                 raw["number"],
+			"search_time_range":      raw["search_time_range"],
+			"search_time_range_unit": raw["search_time_range_unit"],
+		})
+	}
+	return requests
+}
+
+// buildKeywordsAlarmFrequencyBodyParams builds the query frequency, only the fields used by the frequency type
+// are sent and the unused ones are left as zero values.
+func buildKeywordsAlarmFrequencyBodyParams(rawArray []interface{}) map[string]interface{} {
+	if len(rawArray) == 0 {
+		return nil
+	}
+	raw, ok := rawArray[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	params := map[string]interface{}{
+		"type":            raw["type"],
+		"cron_expr":       "",
+		"hour_of_day":     0,
+		"day_of_week":     0,
+		"fixed_rate":      0,
+		"fixed_rate_unit": "",
+	}
+	switch raw["type"] {
+	case "CRON":
+		params["cron_expr"] = raw["cron_expression"]
+	case "DAILY":
+		params["hour_of_day"] = raw["hour_of_day"]
+	case "WEEKLY":
+		params["hour_of_day"] = raw["hour_of_day"]
+		params["day_of_week"] = raw["day_of_week"]
+	case "FIXED_RATE":
+		params["fixed_rate"] = raw["fixed_rate"]
+		params["fixed_rate_unit"] = raw["fixed_rate_unit"]
+	}
+	return params
+}
+
+func buildKeywordsAlarmRuleBodyParams(d *schema.ResourceData, domainId string) map[string]interface{} {
+	return map[string]interface{}{
+		"keywords_alarm_rule_name":        d.Get("name"),
+		"keywords_alarm_rule_description": d.Get("description"),
+		"keywords_requests":               buildKeywordsRequestsBodyParams(d.Get("keywords_requests").([]interface{})),
+		"frequency":                       buildKeywordsAlarmFrequencyBodyParams(d.Get("frequency").([]interface{})),
+		"keywords_alarm_level":            d.Get("alarm_level"),
+		"keywords_alarm_send":             false,
+		"trigger_condition_count":         d.Get("trigger_condition_count"),
+		"trigger_condition_frequency":     d.Get("trigger_condition_frequency"),
+		"domain_id":                       domainId,
+	}
+}
+
+func resourceLtsKeywordsAlarmRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("lts", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating LTS client: %s", err)
+	}
+
+	createPath := client.Endpoint + strings.ReplaceAll(keywordsAlarmRuleHttpUrl, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody:         buildKeywordsAlarmRuleBodyParams(d, cfg.DomainID),
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating LTS keywords alarm rule: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := utils.PathSearch("keywords_alarm_rule_id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the LTS keywords alarm rule ID in the API response")
+	}
+
+	d.SetId(id)
+	return resourceLtsKeywordsAlarmRuleRead(ctx, d, meta)
+}
+
+func flattenKeywordsRequests(requests interface{}) []map[string]interface{} {
+	rawArray, _ := requests.([]interface{})
+	result := make([]map[string]interface{}, 0, len(rawArray))
+	for _, v := range rawArray {
+		result = append(result, map[string]interface{}{
+			"log_group_id":           utils.PathSearch("log_group_id", v, nil),
+			"log_stream_id":          utils.PathSearch("log_stream_id", v, nil),
+			"keywords":               utils.PathSearch("keywords", v, nil),
+			"condition":              utils.PathSearch("condition", v, nil),
+			"number":                 utils.PathSearch("number", v, nil),
+			"search_time_range":      utils.PathSearch("search_time_range", v, nil),
+			"search_time_range_unit": utils.PathSearch("search_time_range_unit", v, nil),
+		})
+	}
+	return result
+}
+
+func flattenKeywordsAlarmFrequency(frequency interface{}) []map[string]interface{} {
+	if frequency == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"type":            utils.PathSearch("type", frequency, nil),
+			"cron_expression": utils.PathSearch("cron_expr", frequency, nil),
+			"hour_of_day":     utils.PathSearch("hour_of_day", frequency, nil),
+			"day_of_week":     utils.PathSearch("day_of_week", frequency, nil),
+			"fixed_rate":      utils.PathSearch("fixed_rate", frequency, nil),
+			"fixed_rate_unit": utils.PathSearch("fixed_rate_unit", frequency, nil),
+		},
+	}
+}
+
+func resourceLtsKeywordsAlarmRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("lts", region)
+	if err != nil {
+		return diag.Errorf("error creating LTS client: %s", err)
+	}
+
+	getPath := client.Endpoint + strings.ReplaceAll(keywordsAlarmRuleHttpUrl, "{project_id}", client.ProjectID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving LTS keywords alarm rule")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rule := utils.PathSearch(fmt.Sprintf("keywords_alarm_rules[?keywords_alarm_rule_id=='%s']|[0]", d.Id()),
+		respBody, nil)
+	if rule == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving LTS keywords alarm rule")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("keywords_alarm_rule_name", rule, nil)),
+		d.Set("description", utils.PathSearch("keywords_alarm_rule_description", rule, nil)),
+		d.Set("keywords_requests", flattenKeywordsRequests(utils.PathSearch("keywords_requests", rule, nil))),
+		d.Set("frequency", flattenKeywordsAlarmFrequency(utils.PathSearch("frequency", rule, nil))),
+		d.Set("alarm_level", utils.PathSearch("keywords_alarm_level", rule, nil)),
+		d.Set("trigger_condition_count", utils.PathSearch("trigger_condition_count", rule, 1)),
+		d.Set("trigger_condition_frequency", utils.PathSearch("trigger_condition_frequency", rule, 1)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting LTS keywords alarm rule fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLtsKeywordsAlarmRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("lts", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating LTS client: %s", err)
+	}
+
+	updatePath := client.Endpoint + strings.ReplaceAll(keywordsAlarmRuleHttpUrl, "{project_id}", client.ProjectID)
+	updateBody := buildKeywordsAlarmRuleBodyParams(d, cfg.DomainID)
+	updateBody["keywords_alarm_rule_id"] = d.Id()
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody:         updateBody,
+	}
+	if _, err = client.Request("PUT", updatePath, &updateOpt); err != nil {
+		return diag.Errorf("error updating LTS keywords alarm rule (%s): %s", d.Id(), err)
+	}
+
+	return resourceLtsKeywordsAlarmRuleRead(ctx, d, meta)
+}
+
+func resourceLtsKeywordsAlarmRuleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("lts", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating LTS client: %s", err)
+	}
+
+	deletePath := client.Endpoint + strings.ReplaceAll(keywordsAlarmRuleHttpUrl, "{project_id}", client.ProjectID)
+	deletePath += "/" + d.Id()
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+	}
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting LTS keywords alarm rule")
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package lts
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildKeywordsAlarmFrequencyBodyParams(t *testing.T) {
+	raw := map[string]interface{}{
+		"type":            "WEEKLY",
+		"cron_expression": "0 0 * * *",
+		"hour_of_day":     8,
+		"day_of_week":     2,
+		"fixed_rate":      5,
+		"fixed_rate_unit": "minute",
+	}
+	expected := map[string]interface{}{
+		"type":            "WEEKLY",
+		"cron_expr":       "",
+		"hour_of_day":     8,
+		"day_of_week":     2,
+		"fixed_rate":      0,
+		"fixed_rate_unit": "",
+	}
+	if got := buildKeywordsAlarmFrequencyBodyParams([]interface{}{raw}); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+
+	raw["type"] = "FIXED_RATE"
+	expected = map[string]interface{}{
+		"type":            "FIXED_RATE",
+		"cron_expr":       "",
+		"hour_of_day":     0,
+		"day_of_week":     0,
+		"fixed_rate":      5,
+		"fixed_rate_unit": "minute",
+	}
+	if got := buildKeywordsAlarmFrequencyBodyParams([]interface{}{raw}); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+
+	if got := buildKeywordsAlarmFrequencyBodyParams(nil); got != nil {
+		t.Fatalf("expected nil, got %v", got)
+	}
+}
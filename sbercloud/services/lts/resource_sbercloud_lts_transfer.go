@@ -0,0 +1,389 @@
+package lts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const transferHttpUrl = "v2/{project_id}/transfers"
+
+// ResourceLtsTransfer manages a transfer of the log streams to an OBS bucket or to a DIS stream.
+func ResourceLtsTransfer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLtsTransferCreate,
+		ReadContext:   resourceLtsTransferRead,
+		UpdateContext: resourceLtsTransferUpdate,
+		DeleteContext: resourceLtsTransferDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"log_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"log_streams": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_stream_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"log_stream_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"log_transfer_info": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_transfer_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"OBS", "DIS"}, false),
+						},
+						"log_transfer_mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"cycle", "realTime"}, false),
+						},
+						"log_storage_format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"RAW", "JSON"}, false),
+						},
+						"log_transfer_status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"ENABLE", "DISABLE"}, false),
+						},
+						"log_transfer_detail": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem:     transferDetailSchema(),
+						},
+					},
+				},
+			},
+			"log_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func transferDetailSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"obs_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 5, 6, 12, 30}),
+			},
+			"obs_period_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"min", "hour"}, false),
+			},
+			"obs_bucket_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"obs_dir_prefix_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"obs_prefix_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"obs_time_zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"log_transfer_info.0.log_transfer_detail.0.obs_time_zone_id"},
+			},
+			"obs_time_zone_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"log_transfer_info.0.log_transfer_detail.0.obs_time_zone"},
+			},
+			"obs_encrypted_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"obs_encrypted_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dis_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dis_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func buildTransferLogStreamsBodyParams(rawArray []interface{}) []map[string]interface{} {
+	streams := make([]map[string]interface{}, 0, len(rawArray))
+	for _, v := range rawArray {
+		raw, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		streams = append(streams, utils.RemoveNil(map[string]interface{}{
+			"log_stream_id":   raw["log_stream_id"],
+			"log_stream_name": utils.ValueIngoreEmpty(raw["log_stream_name"]),
+		}))
+	}
+	return streams
+}
+
+// buildTransferInfoBodyParams builds the transfer information, the type and the mode can not be changed, so they
+// are only sent on creation.
+func buildTransferInfoBodyParams(rawArray []interface{}, isCreate bool) map[string]interface{} {
+	if len(rawArray) == 0 {
+		return nil
+	}
+	raw, ok := rawArray[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	params := map[string]interface{}{
+		"log_storage_format":  raw["log_storage_format"],
+		"log_transfer_status": raw["log_transfer_status"],
+		"log_transfer_detail": buildTransferDetailBodyParams(raw["log_transfer_detail"].([]interface{})),
+	}
+	if isCreate {
+		params["log_transfer_type"] = raw["log_transfer_type"]
+		params["log_transfer_mode"] = raw["log_transfer_mode"]
+	}
+	return params
+}
+
+func buildTransferDetailBodyParams(rawArray []interface{}) map[string]interface{} {
+	if len(rawArray) == 0 {
+		return nil
+	}
+	raw, ok := rawArray[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	params := map[string]interface{}{
+		"obs_period":           utils.ValueIngoreEmpty(raw["obs_period"]),
+		"obs_period_unit":      utils.ValueIngoreEmpty(raw["obs_period_unit"]),
+		"obs_bucket_name":      utils.ValueIngoreEmpty(raw["obs_bucket_name"]),
+		"obs_dir_pre_fix_name": utils.ValueIngoreEmpty(raw["obs_dir_prefix_name"]),
+		"obs_prefix_name":      utils.ValueIngoreEmpty(raw["obs_prefix_name"]),
+		"obs_time_zone":        utils.ValueIngoreEmpty(raw["obs_time_zone"]),
+		"obs_time_zone_id":     utils.ValueIngoreEmpty(raw["obs_time_zone_id"]),
+		"obs_encrypted_id":     utils.ValueIngoreEmpty(raw["obs_encrypted_id"]),
+		"dis_id":               utils.ValueIngoreEmpty(raw["dis_id"]),
+		"dis_name":             utils.ValueIngoreEmpty(raw["dis_name"]),
+	}
+	if encrypted, ok := raw["obs_encrypted_enable"].(bool); ok && encrypted {
+		params["obs_encrypted_enable"] = true
+	}
+	return utils.RemoveNil(params)
+}
+
+func resourceLtsTransferCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("lts", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating LTS client: %s", err)
+	}
+
+	createPath := client.Endpoint + strings.ReplaceAll(transferHttpUrl, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody: map[string]interface{}{
+			"log_group_id":      d.Get("log_group_id"),
+			"log_streams":       buildTransferLogStreamsBodyParams(d.Get("log_streams").([]interface{})),
+			"log_transfer_info": buildTransferInfoBodyParams(d.Get("log_transfer_info").([]interface{}), true),
+		},
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating LTS transfer: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := utils.PathSearch("log_transfer_id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the LTS transfer ID in the API response")
+	}
+
+	d.SetId(id)
+	return resourceLtsTransferRead(ctx, d, meta)
+}
+
+func flattenTransferLogStreams(streams interface{}) []map[string]interface{} {
+	rawArray, _ := streams.([]interface{})
+	result := make([]map[string]interface{}, 0, len(rawArray))
+	for _, v := range rawArray {
+		result = append(result, map[string]interface{}{
+			"log_stream_id":   utils.PathSearch("log_stream_id", v, nil),
+			"log_stream_name": utils.PathSearch("log_stream_name", v, nil),
+		})
+	}
+	return result
+}
+
+func flattenTransferInfo(info interface{}) []map[string]interface{} {
+	if info == nil {
+		return nil
+	}
+
+	detail := utils.PathSearch("log_transfer_detail", info, nil)
+	return []map[string]interface{}{
+		{
+			"log_transfer_type":   utils.PathSearch("log_transfer_type", info, nil),
+			"log_transfer_mode":   utils.PathSearch("log_transfer_mode", info, nil),
+			"log_storage_format":  utils.PathSearch("log_storage_format", info, nil),
+			"log_transfer_status": utils.PathSearch("log_transfer_status", info, nil),
+			"log_transfer_detail": []map[string]interface{}{
+				{
+					"obs_period":           int(utils.PathSearch("obs_period", detail, float64(0)).(float64)),
+					"obs_period_unit":      utils.PathSearch("obs_period_unit", detail, nil),
+					"obs_bucket_name":      utils.PathSearch("obs_bucket_name", detail, nil),
+					"obs_dir_prefix_name":  utils.PathSearch("obs_dir_pre_fix_name", detail, nil),
+					"obs_prefix_name":      utils.PathSearch("obs_prefix_name", detail, nil),
+					"obs_time_zone":        utils.PathSearch("obs_time_zone", detail, nil),
+					"obs_time_zone_id":     utils.PathSearch("obs_time_zone_id", detail, nil),
+					"obs_encrypted_enable": utils.PathSearch("obs_encrypted_enable", detail, false),
+					"obs_encrypted_id":     utils.PathSearch("obs_encrypted_id", detail, nil),
+					"dis_id":               utils.PathSearch("dis_id", detail, nil),
+					"dis_name":             utils.PathSearch("dis_name", detail, nil),
+				},
+			},
+		},
+	}
+}
+
+func resourceLtsTransferRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("lts", region)
+	if err != nil {
+		return diag.Errorf("error creating LTS client: %s", err)
+	}
+
+	getPath := client.Endpoint + strings.ReplaceAll(transferHttpUrl, "{project_id}", client.ProjectID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving LTS transfer")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	transfer := utils.PathSearch(fmt.Sprintf("log_transfers[?log_transfer_id=='%s']|[0]", d.Id()), respBody, nil)
+	if transfer == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving LTS transfer")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("log_group_id", utils.PathSearch("log_group_id", transfer, nil)),
+		d.Set("log_group_name", utils.PathSearch("log_group_name", transfer, nil)),
+		d.Set("log_streams", flattenTransferLogStreams(utils.PathSearch("log_streams", transfer, nil))),
+		d.Set("log_transfer_info", flattenTransferInfo(utils.PathSearch("log_transfer_info", transfer, nil))),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting LTS transfer fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLtsTransferUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("lts", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating LTS client: %s", err)
+	}
+
+	updatePath := client.Endpoint + strings.ReplaceAll(transferHttpUrl, "{project_id}", client.ProjectID)
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody: map[string]interface{}{
+			"log_transfer_id":   d.Id(),
+			"log_transfer_info": buildTransferInfoBodyParams(d.Get("log_transfer_info").([]interface{}), false),
+		},
+	}
+	if _, err = client.Request("PUT", updatePath, &updateOpt); err != nil {
+		return diag.Errorf("error updating LTS transfer (%s): %s", d.Id(), err)
+	}
+
+	return resourceLtsTransferRead(ctx, d, meta)
+}
+
+func resourceLtsTransferDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("lts", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating LTS client: %s", err)
+	}
+
+	deletePath := client.Endpoint + strings.ReplaceAll(transferHttpUrl, "{project_id}", client.ProjectID)
+	deletePath += fmt.Sprintf("?log_transfer_id=%s", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+	}
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting LTS transfer")
+	}
+
+	return nil
+}
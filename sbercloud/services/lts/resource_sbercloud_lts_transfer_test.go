@@ -0,0 +1,55 @@
+package lts
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildTransferInfoBodyParams(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"log_transfer_type":   "OBS",
+			"log_transfer_mode":   "cycle",
+			"log_storage_format":  "RAW",
+			"log_transfer_status": "ENABLE",
+			"log_transfer_detail": []interface{}{
+				map[string]interface{}{
+					"obs_period":           3,
+					"obs_period_unit":      "hour",
+					"obs_bucket_name":      "logs",
+					"obs_dir_prefix_name":  "dir",
+					"obs_prefix_name":      "",
+					"obs_time_zone":        "",
+					"obs_time_zone_id":     "",
+					"obs_encrypted_enable": false,
+					"obs_encrypted_id":     "",
+					"dis_id":               "",
+					"dis_name":             "",
+				},
+			},
+		},
+	}
+	detail := map[string]interface{}{
+		"obs_period":           3,
+		"obs_period_unit":      "hour",
+		"obs_bucket_name":      "logs",
+		"obs_dir_pre_fix_name": "dir",
+	}
+
+	expected := map[string]interface{}{
+		"log_transfer_type":   "OBS",
+		"log_transfer_mode":   "cycle",
+		"log_storage_format":  "RAW",
+		"log_transfer_status": "ENABLE",
+		"log_transfer_detail": detail,
+	}
+	if got := buildTransferInfoBodyParams(raw, true); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+
+	delete(expected, "log_transfer_type")
+	delete(expected, "log_transfer_mode")
+	if got := buildTransferInfoBodyParams(raw, false); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,131 @@
+package obs
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// obsSubResourceClient sends the requests of the bucket sub-resources which are not supported by the SDK, such as
+// object-lock and inventory. The OBS signature only signs the sub-resources known by the SDK, so the requests are
+// signed with the V4 signature, which signs all the query parameters, and sent with the HTTP client of the provider.
+type obsSubResourceClient struct {
+	signer     *obs.ObsClient
+	httpClient *http.Client
+	userAgent  string
+}
+
+// newObsSubResourceClient creates the client from the OBS client of the config package, so the security key is
+// refreshed under the lock and the endpoint is resolved in the same way as the other OBS resources.
+func newObsSubResourceClient(cfg *config.Config, region string) (*obsSubResourceClient, error) {
+	obsClient, err := cfg.ObjectStorageClient(region)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := getObsClientEndpoint(obsClient)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cfg.SecurityKeyExpiresAt.IsZero() {
+		// the key may be reloaded by the other resources in the meantime
+		cfg.SecurityKeyLock.Lock()
+		defer cfg.SecurityKeyLock.Unlock()
+	}
+	var signer *obs.ObsClient
+	if cfg.SecurityToken != "" {
+		signer, err = obs.New(cfg.AccessKey, cfg.SecretKey, endpoint, obs.WithSignature(obs.SignatureV4),
+			obs.WithRegion(region), obs.WithSecurityToken(cfg.SecurityToken))
+	} else {
+		signer, err = obs.New(cfg.AccessKey, cfg.SecretKey, endpoint, obs.WithSignature(obs.SignatureV4),
+			obs.WithRegion(region))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &obsSubResourceClient{
+		signer:     signer,
+		httpClient: &cfg.DomainClient.HTTPClient,
+		userAgent:  cfg.DomainClient.UserAgent.Join(),
+	}, nil
+}
+
+// getObsClientEndpoint returns the endpoint of the OBS client, which is the URL signed without the bucket.
+func getObsClientEndpoint(client *obs.ObsClient) (string, error) {
+	output, err := client.CreateSignedUrl(&obs.CreateSignedUrlInput{Method: obs.HttpMethodGet})
+	if err != nil {
+		return "", err
+	}
+	signedUrl, err := url.Parse(output.SignedUrl)
+	if err != nil {
+		return "", fmt.Errorf("error parsing the OBS endpoint: %s", err)
+	}
+	return fmt.Sprintf("%s://%s", signedUrl.Scheme, signedUrl.Host), nil
+}
+
+// doBucketSubResourceRequest sends the signed request of the bucket sub-resource and returns the raw body of the
+// response, an obs.ObsError is returned if the status code of the response is not 2xx.
+func (c *obsSubResourceClient) doBucketSubResourceRequest(method obs.HttpMethodType, bucket string,
+	params map[string]string, body []byte) ([]byte, error) {
+	input := &obs.CreateSignedUrlInput{
+		Method:      method,
+		Bucket:      bucket,
+		QueryParams: params,
+	}
+	if body != nil {
+		input.Headers = map[string]string{
+			"Content-MD5":  obs.Base64Md5(body),
+			"Content-Type": "application/xml",
+		}
+	}
+	signedUrl, err := c.signer.CreateSignedUrl(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(string(method), signedUrl.SignedUrl, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range signedUrl.ActualSignedRequestHeaders {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, obs.ParseResponseToObsError(resp, false)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// isObsResourceNotFound reports whether the bucket or the configuration of the bucket does not exist.
+func isObsResourceNotFound(err error) bool {
+	obsError, ok := err.(obs.ObsError)
+	return ok && obsError.StatusCode == 404
+}
+
+func getObsError(action string, bucket string, err error) error {
+	if _, ok := err.(obs.ObsError); ok {
+		return fmt.Errorf("%s %s: %s", action, bucket, err)
+	}
+	return err
+}
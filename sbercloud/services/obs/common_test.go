@@ -0,0 +1,87 @@
+package obs
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+)
+
+func TestGetObsClientEndpoint(t *testing.T) {
+	client, err := obs.New("ak", "sk", "https://obs.ru-moscow-1.hc.sbercloud.ru/")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	endpoint, err := getObsClientEndpoint(client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := "https://obs.ru-moscow-1.hc.sbercloud.ru:443"; endpoint != expected {
+		t.Errorf("expected the endpoint %s, got %s", expected, endpoint)
+	}
+}
+
+func testSubResourceClient(t *testing.T, handler http.HandlerFunc) *obsSubResourceClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	signer, err := obs.New("ak", "sk", server.URL, obs.WithSignature(obs.SignatureV4),
+		obs.WithRegion("ru-moscow-1"), obs.WithPathStyle(true))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return &obsSubResourceClient{
+		signer:     signer,
+		httpClient: server.Client(),
+		userAgent:  "terraform-provider-iac",
+	}
+}
+
+func TestDoBucketSubResourceRequest(t *testing.T) {
+	client := testSubResourceClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPut || r.URL.Path != "/bucket" || !r.URL.Query().Has("object-lock") {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+		if !strings.Contains(r.URL.RawQuery, "X-Amz-Signature=") {
+			t.Errorf("expected the request to be signed with the V4 signature, got %s", r.URL.RawQuery)
+		}
+		if r.Header.Get("Content-MD5") != obs.Base64Md5(body) {
+			t.Errorf("expected the Content-MD5 of the body, got %s", r.Header.Get("Content-MD5"))
+		}
+		if r.UserAgent() != "terraform-provider-iac" {
+			t.Errorf("expected the user agent of the provider, got %s", r.UserAgent())
+		}
+		_, _ = w.Write([]byte("<ok/>"))
+	})
+
+	body, err := client.doBucketSubResourceRequest(obs.HttpMethodPut, "bucket",
+		map[string]string{objectLockSubResource: ""}, []byte("<ObjectLockConfiguration/>"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(body) != "<ok/>" {
+		t.Errorf("expected the body of the response, got %s", body)
+	}
+}
+
+func TestDoBucketSubResourceRequest_notFound(t *testing.T) {
+	client := testSubResourceClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("<Error><Code>NoSuchInventoryConfiguration</Code>" +
+			"<Message>The specified configuration does not exist.</Message></Error>"))
+	})
+
+	_, err := client.doBucketSubResourceRequest(obs.HttpMethodGet, "bucket", buildInventoryParams("daily"), nil)
+	if !isObsResourceNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+	if obsError := err.(obs.ObsError); obsError.Code != "NoSuchInventoryConfiguration" {
+		t.Errorf("expected the code of the error to be parsed, got %s", obsError.Code)
+	}
+}
@@ -0,0 +1,211 @@
+package obs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// The maximum number of the objects returned in a page of the ListObjects API.
+const listObjectsPageSize = 1000
+
+func DataSourceObsBucketObjects() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceObsBucketObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"delimiter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"marker": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_keys": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"common_prefixes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// listBucketObjects queries the objects page by page until the number of the objects and the common prefixes reaches
+// the max keys or all the objects are listed.
+func listBucketObjects(client *obs.ObsClient, input *obs.ListObjectsInput, maxKeys int) ([]obs.Content, []string,
+	error) {
+	var objects []obs.Content
+	var commonPrefixes []string
+	for remaining := maxKeys; remaining > 0; {
+		input.MaxKeys = remaining
+		if input.MaxKeys > listObjectsPageSize {
+			input.MaxKeys = listObjectsPageSize
+		}
+		output, err := client.ListObjects(input)
+		if err != nil {
+			return nil, nil, err
+		}
+		objects = append(objects, output.Contents...)
+		commonPrefixes = append(commonPrefixes, output.CommonPrefixes...)
+		remaining -= len(output.Contents) + len(output.CommonPrefixes)
+		if !output.IsTruncated {
+			break
+		}
+
+		input.Marker = nextListObjectsMarker(output)
+		if input.Marker == "" {
+			return nil, nil, fmt.Errorf("unable to find the marker of the next page of the objects")
+		}
+	}
+	return objects, commonPrefixes, nil
+}
+
+// nextListObjectsMarker returns the marker of the next page. NextMarker may be missing from the truncated page,
+// the page then ends with the last object or common prefix, whichever is later in order.
+func nextListObjectsMarker(output *obs.ListObjectsOutput) string {
+	if output.NextMarker != "" {
+		return output.NextMarker
+	}
+
+	marker := ""
+	if len(output.Contents) > 0 {
+		marker = output.Contents[len(output.Contents)-1].Key
+	}
+	if len(output.CommonPrefixes) > 0 {
+		if prefix := output.CommonPrefixes[len(output.CommonPrefixes)-1]; prefix > marker {
+			marker = prefix
+		}
+	}
+	return marker
+}
+
+func flattenBucketObjects(objects []obs.Content) ([]string, []map[string]interface{}) {
+	keys := make([]string, 0, len(objects))
+	result := make([]map[string]interface{}, 0, len(objects))
+	for _, object := range objects {
+		keys = append(keys, object.Key)
+		result = append(result, map[string]interface{}{
+			"key":           object.Key,
+			"size":          object.Size,
+			"etag":          object.ETag,
+			"storage_class": normalizeStorageClass(string(object.StorageClass)),
+			"last_modified": object.LastModified.Format(time.RFC3339),
+			"owner":         object.Owner.ID,
+		})
+	}
+	return keys, result
+}
+
+// normalizeStorageClass converts the storage classes returned by the S3 compatible APIs to the OBS storage classes.
+func normalizeStorageClass(class string) string {
+	switch class {
+	case "STANDARD_IA":
+		return "WARM"
+	case "GLACIER":
+		return "COLD"
+	default:
+		return class
+	}
+}
+
+func dataSourceObsBucketObjectsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.ObjectStorageClientWithSignature(region)
+	if err != nil {
+		return diag.Errorf("error creating OBS Client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	input := &obs.ListObjectsInput{
+		Bucket: bucket,
+		Marker: d.Get("marker").(string),
+	}
+	input.Prefix = d.Get("prefix").(string)
+	input.Delimiter = d.Get("delimiter").(string)
+	objects, commonPrefixes, err := listBucketObjects(client, input, d.Get("max_keys").(int))
+	if err != nil {
+		return diag.FromErr(getObsError("Error listing objects of OBS bucket", bucket, err))
+	}
+
+	dataSourceId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(dataSourceId)
+
+	keys, objectList := flattenBucketObjects(objects)
+	mErr := multierror.Append(
+		d.Set("region", region),
+		d.Set("keys", keys),
+		d.Set("common_prefixes", commonPrefixes),
+		d.Set("objects", objectList),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
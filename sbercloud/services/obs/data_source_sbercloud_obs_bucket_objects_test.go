@@ -0,0 +1,92 @@
+package obs
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+)
+
+func TestFlattenBucketObjects(t *testing.T) {
+	lastModified := time.Date(2023, 6, 1, 8, 30, 0, 0, time.UTC)
+	objects := []obs.Content{
+		{
+			Key:          "logs/app.log",
+			Size:         1024,
+			ETag:         "\"0cc175b9c0f1b6a831c399e269772661\"",
+			StorageClass: "STANDARD_IA",
+			LastModified: lastModified,
+			Owner:        obs.Owner{ID: "0123"},
+		},
+	}
+
+	keys, result := flattenBucketObjects(objects)
+	if !reflect.DeepEqual(keys, []string{"logs/app.log"}) {
+		t.Errorf("expected the keys [logs/app.log], got %v", keys)
+	}
+	expected := []map[string]interface{}{
+		{
+			"key":           "logs/app.log",
+			"size":          int64(1024),
+			"etag":          "\"0cc175b9c0f1b6a831c399e269772661\"",
+			"storage_class": "WARM",
+			"last_modified": "2023-06-01T08:30:00Z",
+			"owner":         "0123",
+		},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestNextListObjectsMarker(t *testing.T) {
+	testCases := []struct {
+		name     string
+		output   obs.ListObjectsOutput
+		expected string
+	}{
+		{
+			name:     "next marker",
+			output:   obs.ListObjectsOutput{NextMarker: "logs/b.log", Contents: []obs.Content{{Key: "logs/a.log"}}},
+			expected: "logs/b.log",
+		},
+		{
+			name:     "last object",
+			output:   obs.ListObjectsOutput{Contents: []obs.Content{{Key: "a.log"}, {Key: "b.log"}}},
+			expected: "b.log",
+		},
+		{
+			name:     "common prefixes only",
+			output:   obs.ListObjectsOutput{CommonPrefixes: []string{"logs/", "tmp/"}},
+			expected: "tmp/",
+		},
+		{
+			name: "common prefix after the last object",
+			output: obs.ListObjectsOutput{
+				Contents:       []obs.Content{{Key: "a.log"}},
+				CommonPrefixes: []string{"logs/"},
+			},
+			expected: "logs/",
+		},
+		{
+			name: "last object after the common prefixes",
+			output: obs.ListObjectsOutput{
+				Contents:       []obs.Content{{Key: "z.log"}},
+				CommonPrefixes: []string{"logs/"},
+			},
+			expected: "z.log",
+		},
+		{
+			name:     "empty page",
+			output:   obs.ListObjectsOutput{},
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := nextListObjectsMarker(&tc.output); got != tc.expected {
+			t.Errorf("[%s] expected the marker %q, got %q", tc.name, tc.expected, got)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package obs
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const inventorySubResource = "inventory"
+
+type inventoryDestination struct {
+	Format string `xml:"Format"`
+	Bucket string `xml:"Bucket"`
+	Prefix string `xml:"Prefix,omitempty"`
+}
+
+type inventoryFilter struct {
+	Prefix string `xml:"Prefix"`
+}
+
+type inventoryConfiguration struct {
+	XMLName                xml.Name             `xml:"InventoryConfiguration"`
+	ID                     string               `xml:"Id"`
+	IsEnabled              bool                 `xml:"IsEnabled"`
+	Filter                 *inventoryFilter     `xml:"Filter,omitempty"`
+	Destination            inventoryDestination `xml:"Destination"`
+	Frequency              string               `xml:"Schedule>Frequency"`
+	IncludedObjectVersions string               `xml:"IncludedObjectVersions"`
+	OptionalFields         []string             `xml:"OptionalFields>Field,omitempty"`
+}
+
+// ResourceObsBucketInventory manages an inventory configuration of the OBS bucket, the inventories of the objects are
+// generated as the CSV files in the destination bucket periodically. The ID is formatted as <bucket>/<name>.
+func ResourceObsBucketInventory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketInventoryPut,
+		ReadContext:   resourceObsBucketInventoryRead,
+		UpdateContext: resourceObsBucketInventoryPut,
+		DeleteContext: resourceObsBucketInventoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"frequency": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Daily", "Weekly"}, false),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"destination_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"included_object_versions": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Current",
+				ValidateFunc: validation.StringInSlice([]string{"All", "Current"}, false),
+			},
+			"optional_fields": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Size", "LastModifiedDate", "ETag", "StorageClass", "IsMultipartUploaded",
+						"ReplicationStatus", "EncryptionStatus",
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+// parseInventoryId splits the resource ID into the bucket name and the inventory name.
+func parseInventoryId(id string) (bucket, name string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format, want '<bucket>/<name>', but got '%s'", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func buildInventoryParams(name string) map[string]string {
+	return map[string]string{
+		inventorySubResource: "",
+		"id":                 name,
+	}
+}
+
+func resourceObsBucketInventoryPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := newObsSubResourceClient(cfg, cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating OBS Client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	name := d.Get("name").(string)
+	configuration := inventoryConfiguration{
+		ID:        name,
+		IsEnabled: d.Get("enabled").(bool),
+		Destination: inventoryDestination{
+			Format: "CSV",
+			Bucket: d.Get("destination_bucket").(string),
+			Prefix: d.Get("destination_prefix").(string),
+		},
+		Frequency:              d.Get("frequency").(string),
+		IncludedObjectVersions: d.Get("included_object_versions").(string),
+		OptionalFields:         utils.ExpandToStringList(d.Get("optional_fields").(*schema.Set).List()),
+	}
+	if prefix := d.Get("prefix").(string); prefix != "" {
+		configuration.Filter = &inventoryFilter{Prefix: prefix}
+	}
+	body, err := xml.Marshal(configuration)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = client.doBucketSubResourceRequest(obs.HttpMethodPut, bucket, buildInventoryParams(name), body)
+	if err != nil {
+		return diag.FromErr(getObsError("Error setting inventory of OBS bucket", bucket, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", bucket, name))
+	return resourceObsBucketInventoryRead(ctx, d, meta)
+}
+
+func resourceObsBucketInventoryRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := newObsSubResourceClient(cfg, region)
+	if err != nil {
+		return diag.Errorf("error creating OBS Client: %s", err)
+	}
+
+	bucket, name, err := parseInventoryId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	body, err := client.doBucketSubResourceRequest(obs.HttpMethodGet, bucket, buildInventoryParams(name), nil)
+	if err != nil {
+		if isObsResourceNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(getObsError("Error retrieving inventory of OBS bucket", bucket, err))
+	}
+	var configuration inventoryConfiguration
+	if err = xml.Unmarshal(body, &configuration); err != nil {
+		return diag.Errorf("error parsing inventory of OBS bucket %s: %s", bucket, err)
+	}
+
+	var prefix string
+	if configuration.Filter != nil {
+		prefix = configuration.Filter.Prefix
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("bucket", bucket),
+		d.Set("name", name),
+		d.Set("destination_bucket", configuration.Destination.Bucket),
+		d.Set("destination_prefix", configuration.Destination.Prefix),
+		d.Set("frequency", configuration.Frequency),
+		d.Set("enabled", configuration.IsEnabled),
+		d.Set("prefix", prefix),
+		d.Set("included_object_versions", configuration.IncludedObjectVersions),
+		d.Set("optional_fields", configuration.OptionalFields),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting OBS bucket inventory fields: %s", err)
+	}
+	return nil
+}
+
+func resourceObsBucketInventoryDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := newObsSubResourceClient(cfg, cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating OBS Client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	_, err = client.doBucketSubResourceRequest(obs.HttpMethodDelete, bucket,
+		buildInventoryParams(d.Get("name").(string)), nil)
+	if err != nil {
+		if isObsResourceNotFound(err) {
+			return nil
+		}
+		return diag.FromErr(getObsError("Error deleting inventory of OBS bucket", bucket, err))
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package obs
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestParseInventoryId(t *testing.T) {
+	bucket, name, err := parseInventoryId("bucket/daily")
+	if err != nil || bucket != "bucket" || name != "daily" {
+		t.Errorf("expected bucket and daily, got %s, %s and the error: %v", bucket, name, err)
+	}
+
+	for _, id := range []string{"bucket", "bucket/", "/daily"} {
+		if _, _, err = parseInventoryId(id); err == nil {
+			t.Errorf("expected an error when parsing %q", id)
+		}
+	}
+}
+
+func TestInventoryConfigurationXml(t *testing.T) {
+	configuration := inventoryConfiguration{
+		ID:        "daily",
+		IsEnabled: true,
+		Filter:    &inventoryFilter{Prefix: "logs/"},
+		Destination: inventoryDestination{
+			Format: "CSV",
+			Bucket: "destination",
+		},
+		Frequency:              "Daily",
+		IncludedObjectVersions: "Current",
+		OptionalFields:         []string{"Size", "ETag"},
+	}
+	body, err := xml.Marshal(configuration)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := "<InventoryConfiguration><Id>daily</Id><IsEnabled>true</IsEnabled>" +
+		"<Filter><Prefix>logs/</Prefix></Filter><Destination><Format>CSV</Format><Bucket>destination</Bucket>" +
+		"</Destination><Schedule><Frequency>Daily</Frequency></Schedule>" +
+		"<IncludedObjectVersions>Current</IncludedObjectVersions><OptionalFields><Field>Size</Field>" +
+		"<Field>ETag</Field></OptionalFields></InventoryConfiguration>"
+	if string(body) != expected {
+		t.Errorf("expected the body %s, got %s", expected, body)
+	}
+}
@@ -0,0 +1,202 @@
+package obs
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+var notificationEvents = []string{
+	"ObjectCreated:*", "ObjectCreated:Put", "ObjectCreated:Post", "ObjectCreated:Copy",
+	"ObjectCreated:CompleteMultipartUpload", "ObjectRemoved:*", "ObjectRemoved:Delete",
+	"ObjectRemoved:DeleteMarkerCreated",
+}
+
+// ResourceObsBucketNotification manages the event notifications of the OBS bucket, the events are sent to the SMN
+// topics. The ID is the bucket name.
+func ResourceObsBucketNotification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketNotificationPut,
+		ReadContext:   resourceObsBucketNotificationRead,
+		UpdateContext: resourceObsBucketNotificationPut,
+		DeleteContext: resourceObsBucketNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"smn_topic": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     notificationConfigurationSchema(),
+			},
+		},
+	}
+}
+
+func notificationConfigurationSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"urn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(notificationEvents, false),
+				},
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func buildNotificationConfigurations(rawConfigurations []interface{}) []obs.TopicConfiguration {
+	configurations := make([]obs.TopicConfiguration, 0, len(rawConfigurations))
+	for _, rawConfiguration := range rawConfigurations {
+		raw := rawConfiguration.(map[string]interface{})
+		configuration := obs.TopicConfiguration{
+			ID:    raw["id"].(string),
+			Topic: raw["urn"].(string),
+		}
+		for _, event := range raw["events"].(*schema.Set).List() {
+			configuration.Events = append(configuration.Events, obs.EventType(event.(string)))
+		}
+		for _, name := range []string{"prefix", "suffix"} {
+			if value := raw[name].(string); value != "" {
+				configuration.FilterRules = append(configuration.FilterRules, obs.FilterRule{Name: name, Value: value})
+			}
+		}
+		configurations = append(configurations, configuration)
+	}
+	return configurations
+}
+
+func flattenNotificationConfigurations(configurations []obs.TopicConfiguration) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(configurations))
+	for _, configuration := range configurations {
+		events := make([]string, 0, len(configuration.Events))
+		for _, event := range configuration.Events {
+			events = append(events, string(event))
+		}
+		item := map[string]interface{}{
+			"id":     configuration.ID,
+			"urn":    configuration.Topic,
+			"events": events,
+		}
+		for _, rule := range configuration.FilterRules {
+			item[rule.Name] = rule.Value
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func putBucketNotification(client *obs.ObsClient, bucket string, configurations []obs.TopicConfiguration) error {
+	input := &obs.SetBucketNotificationInput{
+		Bucket: bucket,
+		BucketNotification: obs.BucketNotification{
+			TopicConfigurations: configurations,
+		},
+	}
+	_, err := client.SetBucketNotification(input)
+	return err
+}
+
+func resourceObsBucketNotificationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ObjectStorageClient(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating OBS Client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	configurations := buildNotificationConfigurations(d.Get("smn_topic").([]interface{}))
+	if err = putBucketNotification(client, bucket, configurations); err != nil {
+		return diag.FromErr(getObsError("Error setting notifications of OBS bucket", bucket, err))
+	}
+
+	d.SetId(bucket)
+	return resourceObsBucketNotificationRead(ctx, d, meta)
+}
+
+func resourceObsBucketNotificationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.ObjectStorageClient(region)
+	if err != nil {
+		return diag.Errorf("error creating OBS Client: %s", err)
+	}
+
+	output, err := client.GetBucketNotification(d.Id())
+	if err != nil {
+		if isObsResourceNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(getObsError("Error retrieving notifications of OBS bucket", d.Id(), err))
+	}
+	if len(output.TopicConfigurations) == 0 {
+		// The bucket does not have notification configurations
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("bucket", d.Id()),
+		d.Set("smn_topic", flattenNotificationConfigurations(output.TopicConfigurations)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting OBS bucket notification fields: %s", err)
+	}
+	return nil
+}
+
+func resourceObsBucketNotificationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ObjectStorageClient(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating OBS Client: %s", err)
+	}
+
+	// The notifications are removed by putting an empty configuration.
+	if err = putBucketNotification(client, d.Id(), nil); err != nil {
+		if isObsResourceNotFound(err) {
+			return nil
+		}
+		return diag.FromErr(getObsError("Error deleting notifications of OBS bucket", d.Id(), err))
+	}
+	return nil
+}
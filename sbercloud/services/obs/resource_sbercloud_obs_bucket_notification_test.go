@@ -0,0 +1,56 @@
+package obs
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestBuildNotificationConfigurations(t *testing.T) {
+	rawConfigurations := []interface{}{
+		map[string]interface{}{
+			"id":     "created",
+			"urn":    "urn:smn:ru-moscow-1:0123:test",
+			"events": schema.NewSet(schema.HashString, []interface{}{"ObjectCreated:*"}),
+			"prefix": "images/",
+			"suffix": "",
+		},
+	}
+
+	expected := []obs.TopicConfiguration{
+		{
+			ID:          "created",
+			Topic:       "urn:smn:ru-moscow-1:0123:test",
+			Events:      []obs.EventType{obs.ObjectCreatedAll},
+			FilterRules: []obs.FilterRule{{Name: "prefix", Value: "images/"}},
+		},
+	}
+	if got := buildNotificationConfigurations(rawConfigurations); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestFlattenNotificationConfigurations(t *testing.T) {
+	configurations := []obs.TopicConfiguration{
+		{
+			ID:          "removed",
+			Topic:       "urn:smn:ru-moscow-1:0123:test",
+			Events:      []obs.EventType{obs.ObjectRemovedDelete, obs.ObjectRemovedDeleteMarkerCreated},
+			FilterRules: []obs.FilterRule{{Name: "suffix", Value: ".log"}},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"id":     "removed",
+			"urn":    "urn:smn:ru-moscow-1:0123:test",
+			"events": []string{"ObjectRemoved:Delete", "ObjectRemoved:DeleteMarkerCreated"},
+			"suffix": ".log",
+		},
+	}
+	if got := flattenNotificationConfigurations(configurations); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,188 @@
+package obs
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+const objectLockSubResource = "object-lock"
+
+type objectLockRetention struct {
+	Mode  string `xml:"Mode"`
+	Days  int    `xml:"Days,omitempty"`
+	Years int    `xml:"Years,omitempty"`
+}
+
+type objectLockRule struct {
+	DefaultRetention objectLockRetention `xml:"DefaultRetention"`
+}
+
+type objectLockConfiguration struct {
+	XMLName           xml.Name        `xml:"ObjectLockConfiguration"`
+	ObjectLockEnabled string          `xml:"ObjectLockEnabled"`
+	Rule              *objectLockRule `xml:"Rule,omitempty"`
+}
+
+// ResourceObsBucketObjectLock manages the WORM (write once read many) of the OBS bucket, the objects are protected
+// from being deleted or overwritten in the default retention period. The ID is the bucket name.
+func ResourceObsBucketObjectLock() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketObjectLockPut,
+		ReadContext:   resourceObsBucketObjectLockRead,
+		UpdateContext: resourceObsBucketObjectLockPut,
+		DeleteContext: resourceObsBucketObjectLockDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "COMPLIANCE",
+				ValidateFunc: validation.StringInSlice([]string{"COMPLIANCE"}, false),
+			},
+			"days": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntBetween(1, 36500),
+				ConflictsWith: []string{"years"},
+			},
+			"years": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+		},
+	}
+}
+
+// buildObjectLockConfiguration builds the configuration which enables the WORM, the default retention is configured
+// only when the days or the years is specified.
+func buildObjectLockConfiguration(mode string, days, years int) objectLockConfiguration {
+	configuration := objectLockConfiguration{
+		ObjectLockEnabled: "Enabled",
+	}
+	if days > 0 || years > 0 {
+		configuration.Rule = &objectLockRule{
+			DefaultRetention: objectLockRetention{
+				Mode:  mode,
+				Days:  days,
+				Years: years,
+			},
+		}
+	}
+	return configuration
+}
+
+func putBucketObjectLock(client *obsSubResourceClient, bucket string, configuration objectLockConfiguration) error {
+	body, err := xml.Marshal(configuration)
+	if err != nil {
+		return err
+	}
+	_, err = client.doBucketSubResourceRequest(obs.HttpMethodPut, bucket,
+		map[string]string{objectLockSubResource: ""}, body)
+	return err
+}
+
+func resourceObsBucketObjectLockPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := newObsSubResourceClient(cfg, cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating OBS Client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	configuration := buildObjectLockConfiguration(d.Get("mode").(string), d.Get("days").(int), d.Get("years").(int))
+	if err = putBucketObjectLock(client, bucket, configuration); err != nil {
+		return diag.FromErr(getObsError("Error setting object lock of OBS bucket", bucket, err))
+	}
+
+	d.SetId(bucket)
+	return resourceObsBucketObjectLockRead(ctx, d, meta)
+}
+
+func resourceObsBucketObjectLockRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := newObsSubResourceClient(cfg, region)
+	if err != nil {
+		return diag.Errorf("error creating OBS Client: %s", err)
+	}
+
+	body, err := client.doBucketSubResourceRequest(obs.HttpMethodGet, d.Id(),
+		map[string]string{objectLockSubResource: ""}, nil)
+	if err != nil {
+		if isObsResourceNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(getObsError("Error retrieving object lock of OBS bucket", d.Id(), err))
+	}
+	var configuration objectLockConfiguration
+	if err = xml.Unmarshal(body, &configuration); err != nil {
+		return diag.Errorf("error parsing object lock of OBS bucket %s: %s", d.Id(), err)
+	}
+	if configuration.ObjectLockEnabled != "Enabled" {
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("bucket", d.Id()),
+	)
+	if configuration.Rule != nil {
+		retention := configuration.Rule.DefaultRetention
+		mErr = multierror.Append(mErr,
+			d.Set("mode", retention.Mode),
+			d.Set("days", retention.Days),
+			d.Set("years", retention.Years),
+		)
+	} else {
+		mErr = multierror.Append(mErr,
+			d.Set("days", 0),
+			d.Set("years", 0),
+		)
+	}
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting OBS bucket object lock fields: %s", err)
+	}
+	return nil
+}
+
+func resourceObsBucketObjectLockDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := newObsSubResourceClient(cfg, cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating OBS Client: %s", err)
+	}
+
+	// The WORM can not be disabled once it is enabled, only the default retention is removed.
+	if err = putBucketObjectLock(client, d.Id(), buildObjectLockConfiguration("", 0, 0)); err != nil {
+		if isObsResourceNotFound(err) {
+			return nil
+		}
+		return diag.FromErr(getObsError("Error removing the default retention of OBS bucket", d.Id(), err))
+	}
+	return nil
+}
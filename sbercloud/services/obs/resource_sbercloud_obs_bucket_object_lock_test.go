@@ -0,0 +1,31 @@
+package obs
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestBuildObjectLockConfiguration(t *testing.T) {
+	testCases := []struct {
+		mode        string
+		days, years int
+		expected    string
+	}{
+		{"COMPLIANCE", 30, 0, "<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule>" +
+			"<DefaultRetention><Mode>COMPLIANCE</Mode><Days>30</Days></DefaultRetention></Rule></ObjectLockConfiguration>"},
+		{"COMPLIANCE", 0, 1, "<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule>" +
+			"<DefaultRetention><Mode>COMPLIANCE</Mode><Years>1</Years></DefaultRetention></Rule></ObjectLockConfiguration>"},
+		{"COMPLIANCE", 0, 0,
+			"<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>"},
+	}
+
+	for _, tc := range testCases {
+		body, err := xml.Marshal(buildObjectLockConfiguration(tc.mode, tc.days, tc.years))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if string(body) != tc.expected {
+			t.Errorf("expected the body %s, got %s", tc.expected, body)
+		}
+	}
+}
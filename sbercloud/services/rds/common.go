@@ -0,0 +1,84 @@
+package rds
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/core/sdkerr"
+	"github.com/jmespath/go-jmespath"
+)
+
+// retryErrCodes are the error codes returned while another operation is in progress on the instance.
+var retryErrCodes = map[string]struct{}{
+	"DBS.201202": {},
+	"DBS.200011": {},
+	"DBS.200019": {},
+	"DBS.200047": {},
+	"DBS.200080": {},
+	"DBS.201015": {},
+	"DBS.201206": {},
+	"DBS.212033": {}, // http response code is 403
+	"DBS.280011": {},
+	"DBS.280816": {},
+}
+
+// The RDS instance is limited to only one operation at a time.
+// In addition to locking the instance between the resources, the request is retried when the
+// instance is busy with an operation started outside of Terraform.
+func handleMultiOperationsError(err error) (bool, error) {
+	if err == nil {
+		return false, nil
+	}
+
+	var errorCode string
+	switch e := err.(type) {
+	case *sdkerr.ServiceResponseError:
+		if e.StatusCode == 409 || e.StatusCode == 403 {
+			errorCode = e.ErrorCode
+		}
+	case golangsdk.ErrUnexpectedResponseCode:
+		if e.Actual == 409 {
+			errorCode = parseErrorCode(e.Body)
+		}
+	case golangsdk.ErrDefault403:
+		errorCode = parseErrorCode(e.Body)
+	}
+
+	if _, ok := retryErrCodes[errorCode]; ok {
+		return true, err
+	}
+	return false, err
+}
+
+func parseErrorCode(body []byte) string {
+	var apiError interface{}
+	if err := json.Unmarshal(body, &apiError); err != nil {
+		return ""
+	}
+	errorCode, err := jmespath.Search("errCode||error_code", apiError)
+	if err != nil || errorCode == nil {
+		return ""
+	}
+	if code, ok := errorCode.(string); ok {
+		return code
+	}
+	return ""
+}
+
+// retryMultiOperations calls the operation until it succeeds, fails with an error which is not caused by
+// the other operations of the instance, or the timeout is reached.
+func retryMultiOperations(ctx context.Context, timeout time.Duration, operation func() error) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		retryable, err := handleMultiOperationsError(operation())
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
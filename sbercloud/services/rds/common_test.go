@@ -0,0 +1,39 @@
+package rds
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/core/sdkerr"
+)
+
+func TestHandleMultiOperationsError(t *testing.T) {
+	busyBody := []byte(`{"error_code":"DBS.201202","error_msg":"Another operation is being performed."}`)
+	cases := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{"success", nil, false},
+		{"busy instance", &sdkerr.ServiceResponseError{StatusCode: 409, ErrorCode: "DBS.201202"}, true},
+		{"busy instance with 403", &sdkerr.ServiceResponseError{StatusCode: 403, ErrorCode: "DBS.212033"}, true},
+		{"conflict", &sdkerr.ServiceResponseError{StatusCode: 409, ErrorCode: "DBS.280239"}, false},
+		{"bad request", &sdkerr.ServiceResponseError{StatusCode: 400, ErrorCode: "DBS.201202"}, false},
+		{"golangsdk busy instance", golangsdk.ErrUnexpectedResponseCode{Actual: 409, Body: busyBody}, true},
+		{"golangsdk forbidden", golangsdk.ErrDefault403{
+			ErrUnexpectedResponseCode: golangsdk.ErrUnexpectedResponseCode{Actual: 403, Body: []byte(`{}`)},
+		}, false},
+		{"other error", errors.New("connection reset"), false},
+	}
+
+	for _, tc := range cases {
+		retryable, err := handleMultiOperationsError(tc.err)
+		if retryable != tc.retryable {
+			t.Errorf("[%s] expected the error to be retryable: %t, got %t", tc.name, tc.retryable, retryable)
+		}
+		if (err == nil) != (tc.err == nil) {
+			t.Errorf("[%s] expected the error %v to be returned, got %v", tc.name, tc.err, err)
+		}
+	}
+}
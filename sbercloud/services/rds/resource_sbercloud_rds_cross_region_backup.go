@@ -0,0 +1,207 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	rds "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourceRdsCrossRegionBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsCrossRegionBackupCreateOrUpdate,
+		ReadContext:   resourceRdsCrossRegionBackupRead,
+		UpdateContext: resourceRdsCrossRegionBackupCreateOrUpdate,
+		DeleteContext: resourceRdsCrossRegionBackupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"backup_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"auto", "all"}, false),
+			},
+			"keep_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 1825),
+			},
+			"destination_region": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"destination_project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceRdsCrossRegionBackupCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	policy := rds.OffSiteBackupPolicy{
+		BackupType:           d.Get("backup_type").(string),
+		KeepDays:             int32(d.Get("keep_days").(int)),
+		DestinationRegion:    d.Get("destination_region").(string),
+		DestinationProjectId: d.Get("destination_project_id").(string),
+	}
+	if err = setOffSiteBackupPolicy(ctx, client, instanceId, policy, timeout); err != nil {
+		return diag.Errorf("error setting the cross-region backup strategy of RDS instance (%s): %s", instanceId, err)
+	}
+
+	d.SetId(instanceId)
+	return resourceRdsCrossRegionBackupRead(ctx, d, meta)
+}
+
+func resourceRdsCrossRegionBackupRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	resp, err := client.ShowOffSiteBackupPolicy(&rds.ShowOffSiteBackupPolicyRequest{InstanceId: d.Id()})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving the cross-region backup strategy of RDS instance")
+	}
+
+	policy := flattenOffSiteBackupPolicy(resp.PolicyPara)
+	if policy == nil {
+		log.Printf("[WARN] the cross-region backup of RDS instance %s is disabled", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", c.GetRegion(d)),
+		d.Set("instance_id", d.Id()),
+		d.Set("backup_type", policy["backup_type"]),
+		d.Set("keep_days", policy["keep_days"]),
+		d.Set("destination_region", policy["destination_region"]),
+		d.Set("destination_project_id", policy["destination_project_id"]),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS cross-region backup strategy fields: %s", err)
+	}
+
+	return nil
+}
+
+// flattenOffSiteBackupPolicy merges the policies of the backup types, the API returns one policy for the
+// full backups (auto) and one for the incremental backups. nil is returned if none of them is enabled.
+func flattenOffSiteBackupPolicy(policies *[]rds.GetOffSiteBackupPolicy) map[string]interface{} {
+	if policies == nil {
+		return nil
+	}
+
+	var result map[string]interface{}
+	incremental := false
+	for _, p := range *policies {
+		if p.KeepDays == nil || *p.KeepDays == 0 {
+			continue
+		}
+		if p.BackupType != nil && *p.BackupType == "incremental" {
+			incremental = true
+		}
+		if result == nil {
+			result = map[string]interface{}{
+				"keep_days":              *p.KeepDays,
+				"destination_region":     p.DestinationRegion,
+				"destination_project_id": p.DestinationProjectId,
+			}
+		}
+	}
+	if result == nil {
+		return nil
+	}
+
+	result["backup_type"] = "auto"
+	if incremental {
+		result["backup_type"] = "all"
+	}
+	return result
+}
+
+func resourceRdsCrossRegionBackupDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Id()
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	// setting keep_days to 0 disables the cross-region backup
+	policy := rds.OffSiteBackupPolicy{
+		BackupType:           d.Get("backup_type").(string),
+		KeepDays:             0,
+		DestinationRegion:    d.Get("destination_region").(string),
+		DestinationProjectId: d.Get("destination_project_id").(string),
+	}
+	if err = setOffSiteBackupPolicy(ctx, client, instanceId, policy, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return common.CheckDeletedDiag(d, err, "error disabling the cross-region backup of RDS instance")
+	}
+
+	return nil
+}
+
+func setOffSiteBackupPolicy(ctx context.Context, client *v3.RdsClient, instanceId string,
+	policy rds.OffSiteBackupPolicy, timeout time.Duration) error {
+	request := rds.SetOffSiteBackupPolicyRequest{
+		InstanceId: instanceId,
+		Body: &rds.SetOffSiteBackupPolicyRequestBody{
+			PolicyPara: []rds.OffSiteBackupPolicy{policy},
+		},
+	}
+	return retryMultiOperations(ctx, timeout, func() error {
+		_, err := client.SetOffSiteBackupPolicy(&request)
+		return err
+	})
+}
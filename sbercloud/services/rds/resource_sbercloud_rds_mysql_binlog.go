@@ -0,0 +1,141 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	rds "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourceRdsMysqlBinlog() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsMysqlBinlogCreateOrUpdate,
+		ReadContext:   resourceRdsMysqlBinlogRead,
+		UpdateContext: resourceRdsMysqlBinlogCreateOrUpdate,
+		DeleteContext: resourceRdsMysqlBinlogDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"binlog_retention_hours": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 168),
+			},
+		},
+	}
+}
+
+func resourceRdsMysqlBinlogCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	err = setBinlogRetentionHours(ctx, client, instanceId, int64(d.Get("binlog_retention_hours").(int)), timeout)
+	if err != nil {
+		return diag.Errorf("error setting the binlog retention hours of RDS instance (%s): %s", instanceId, err)
+	}
+
+	d.SetId(instanceId)
+	return resourceRdsMysqlBinlogRead(ctx, d, meta)
+}
+
+func resourceRdsMysqlBinlogRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	resp, err := client.ShowBinlogClearPolicy(&rds.ShowBinlogClearPolicyRequest{InstanceId: d.Id()})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving the binlog retention hours of RDS instance")
+	}
+	if resp.BinlogRetentionHours == nil || *resp.BinlogRetentionHours == 0 {
+		log.Printf("[WARN] the binlog retention of RDS instance %s has been reset to the default policy", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", c.GetRegion(d)),
+		d.Set("instance_id", d.Id()),
+		d.Set("binlog_retention_hours", resp.BinlogRetentionHours),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS binlog fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRdsMysqlBinlogDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Id()
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	// 0 restores the default policy, the binlogs are cleared once they are backed up
+	err = setBinlogRetentionHours(ctx, client, instanceId, 0, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error resetting the binlog retention hours of RDS instance")
+	}
+
+	return nil
+}
+
+func setBinlogRetentionHours(ctx context.Context, client *v3.RdsClient, instanceId string, hours int64,
+	timeout time.Duration) error {
+	request := rds.SetBinlogClearPolicyRequest{
+		InstanceId: instanceId,
+		Body: &rds.BinlogClearPolicyRequestBody{
+			BinlogRetentionHours: hours,
+		},
+	}
+	return retryMultiOperations(ctx, timeout, func() error {
+		_, err := client.SetBinlogClearPolicy(&request)
+		return err
+	})
+}
@@ -0,0 +1,87 @@
+package rds
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rds"
+)
+
+// ResourceRdsConfiguration extends the parameter group resource of huaweicloud with the plan-time list of
+// the changed parameters that only take effect after the instances are restarted. The list only describes the
+// pending changes, so it is cleared once they are applied.
+func ResourceRdsConfiguration() *schema.Resource {
+	resource := rds.ResourceRdsConfiguration()
+	resource.Schema["restart_required_parameters"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	resource.CustomizeDiff = resourceRdsConfigurationCustomizeDiff
+	resource.ReadContext = clearRestartRequiredParameters(resource.ReadContext)
+	resource.UpdateContext = clearRestartRequiredParameters(resource.UpdateContext)
+	return resource
+}
+
+func clearRestartRequiredParameters(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		if err := d.Set("restart_required_parameters", []string{}); err != nil {
+			return append(diags, diag.Errorf("error setting restart_required_parameters: %s", err)...)
+		}
+		return diags
+	}
+}
+
+func resourceRdsConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if !d.HasChange("values") {
+		// the list of the applied changes may be left in the state
+		if len(d.Get("restart_required_parameters").([]interface{})) > 0 {
+			return d.SetNew("restart_required_parameters", []string{})
+		}
+		return nil
+	}
+
+	oldRaw, newRaw := d.GetChange("values")
+	changed := changedRestartRequiredParameters(oldRaw.(map[string]interface{}), newRaw.(map[string]interface{}),
+		d.Get("configuration_parameters").([]interface{}))
+	return d.SetNew("restart_required_parameters", changed)
+}
+
+// changedRestartRequiredParameters returns the sorted names of the parameters whose values are changed
+// and which are marked as restart required in the configuration parameters of the state.
+func changedRestartRequiredParameters(oldValues, newValues map[string]interface{},
+	parameters []interface{}) []string {
+	restartRequired := make(map[string]bool)
+	for _, v := range parameters {
+		param, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if required, _ := param["restart_required"].(bool); required {
+			restartRequired[param["name"].(string)] = true
+		}
+	}
+
+	result := make([]string, 0)
+	for key, val := range newValues {
+		if old, ok := oldValues[key]; ok && old == val {
+			continue
+		}
+		if restartRequired[key] {
+			result = append(result, key)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,79 @@
+package rds
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestChangedRestartRequiredParameters(t *testing.T) {
+	parameters := []interface{}{
+		map[string]interface{}{"name": "max_connections", "restart_required": true},
+		map[string]interface{}{"name": "innodb_buffer_pool_size", "restart_required": true},
+		map[string]interface{}{"name": "div_precision_increment", "restart_required": false},
+	}
+
+	testCases := []struct {
+		name      string
+		oldValues map[string]interface{}
+		newValues map[string]interface{}
+		expected  []string
+	}{
+		{
+			name:      "unchanged",
+			oldValues: map[string]interface{}{"max_connections": "10"},
+			newValues: map[string]interface{}{"max_connections": "10"},
+			expected:  []string{},
+		},
+		{
+			name:      "dynamic parameter",
+			oldValues: map[string]interface{}{"div_precision_increment": "4"},
+			newValues: map[string]interface{}{"div_precision_increment": "8"},
+			expected:  []string{},
+		},
+		{
+			name:      "changed and added",
+			oldValues: map[string]interface{}{"max_connections": "10", "div_precision_increment": "4"},
+			newValues: map[string]interface{}{
+				"max_connections":         "20",
+				"innodb_buffer_pool_size": "1073741824",
+				"div_precision_increment": "8",
+			},
+			expected: []string{"innodb_buffer_pool_size", "max_connections"},
+		},
+		{
+			name:      "unknown parameter",
+			oldValues: map[string]interface{}{},
+			newValues: map[string]interface{}{"unknown": "1"},
+			expected:  []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := changedRestartRequiredParameters(tc.oldValues, tc.newValues, parameters)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestClearRestartRequiredParameters(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceRdsConfiguration().Schema, map[string]interface{}{})
+	d.SetId("configuration-id")
+	if err := d.Set("restart_required_parameters", []string{"max_connections"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	read := func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics { return nil }
+	if diags := clearRestartRequiredParameters(read)(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got := d.Get("restart_required_parameters").([]interface{}); len(got) != 0 {
+		t.Fatalf("expected the applied parameters to be cleared, got %v", got)
+	}
+}
@@ -0,0 +1,253 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	rds "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRdsPgAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsPgAccountCreate,
+		ReadContext:   resourceRdsPgAccountRead,
+		UpdateContext: resourceRdsPgAccountUpdate,
+		DeleteContext: resourceRdsPgAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"memberof": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceRdsPgAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	dbUser := d.Get("name").(string)
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	createOpts := rds.CreatePostgresqlDbUserRequest{
+		InstanceId: instanceId,
+		Body: &rds.PostgresqlUserForCreation{
+			Name:     dbUser,
+			Password: d.Get("password").(string),
+			Comment:  utils.StringIgnoreEmpty(d.Get("description").(string)),
+		},
+	}
+
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		_, err := client.CreatePostgresqlDbUser(&createOpts)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS PostgreSQL account: %s", err)
+	}
+
+	d.SetId(instanceId + "/" + dbUser)
+	return resourceRdsPgAccountRead(ctx, d, meta)
+}
+
+func resourceRdsPgAccountRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and user from resource id
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid id format, must be <instance_id>/<user>")
+	}
+	instanceId := parts[0]
+	dbUser := parts[1]
+
+	user, err := QueryPgAccount(client, instanceId, dbUser)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RDS PostgreSQL account")
+	}
+
+	var memberof []string
+	if user.Memberof != nil {
+		memberof = *user.Memberof
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", c.GetRegion(d)),
+		d.Set("instance_id", instanceId),
+		d.Set("name", user.Name),
+		d.Set("description", user.Comment),
+		d.Set("memberof", memberof),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS PostgreSQL account fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRdsPgAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	dbUser := d.Get("name").(string)
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	if d.HasChange("password") {
+		updateOpts := rds.SetPostgresqlDbUserPwdRequest{
+			InstanceId: instanceId,
+			Body: &rds.DbUserPwdRequest{
+				Name:     dbUser,
+				Password: d.Get("password").(string),
+			},
+		}
+		err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
+			_, err := client.SetPostgresqlDbUserPwd(&updateOpts)
+			return err
+		})
+		if err != nil {
+			return diag.Errorf("error updating the password of RDS PostgreSQL account (%s): %s", dbUser, err)
+		}
+	}
+
+	if d.HasChange("description") {
+		updateOpts := rds.UpdatePostgresqlDbUserCommentRequest{
+			InstanceId: instanceId,
+			UserName:   dbUser,
+			Body: &rds.UpdateDbUserReq{
+				Comment: utils.String(d.Get("description").(string)),
+			},
+		}
+		err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
+			_, err := client.UpdatePostgresqlDbUserComment(&updateOpts)
+			return err
+		})
+		if err != nil {
+			return diag.Errorf("error updating the description of RDS PostgreSQL account (%s): %s", dbUser, err)
+		}
+	}
+
+	return resourceRdsPgAccountRead(ctx, d, meta)
+}
+
+func resourceRdsPgAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	deleteOpts := rds.DeletePostgresqlDbUserRequest{
+		InstanceId: instanceId,
+		UserName:   d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] Delete RDS PostgreSQL account options: %#v", deleteOpts)
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := client.DeletePostgresqlDbUser(&deleteOpts)
+		return err
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting RDS PostgreSQL account")
+	}
+
+	return nil
+}
+
+// QueryPgAccount returns the account of the PostgreSQL instance, golangsdk.ErrDefault404 is returned
+// if it does not exist.
+func QueryPgAccount(client *v3.RdsClient, instanceId, dbUser string) (*rds.PostgresqlUserForList, error) {
+	request := rds.ListPostgresqlDbUserPaginatedRequest{
+		InstanceId: instanceId,
+		Limit:      int32(100),
+		Page:       int32(1),
+	}
+
+	for {
+		response, err := client.ListPostgresqlDbUserPaginated(&request)
+		if err != nil {
+			return nil, err
+		}
+		if response.Users == nil || len(*response.Users) == 0 {
+			break
+		}
+
+		users := *response.Users
+		request.Page++
+		for _, user := range users {
+			if user.Name == dbUser {
+				return &user, nil
+			}
+		}
+	}
+
+	return nil, golangsdk.ErrDefault404{}
+}
@@ -0,0 +1,263 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	rds "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// ResourceRdsPgDatabasePrivilege grants the privileges on a database to the accounts, the ID is formatted as
+// <instance_id>/<db_name>/<user_names>, the user names are sorted and separated by commas.
+func ResourceRdsPgDatabasePrivilege() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsPgDatabasePrivilegeCreate,
+		ReadContext:   resourceRdsPgDatabasePrivilegeRead,
+		DeleteContext: resourceRdsPgDatabasePrivilegeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"users": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"readonly": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildPgDatabasePrivilegeId builds the resource ID, the privileges of different accounts on the same database
+// are managed by different resources.
+func buildPgDatabasePrivilegeId(instanceId, dbName string, rawUsers []interface{}) string {
+	names := make([]string, 0, len(rawUsers))
+	for _, v := range rawUsers {
+		names = append(names, v.(map[string]interface{})["name"].(string))
+	}
+	sort.Strings(names)
+	return fmt.Sprintf("%s/%s/%s", instanceId, dbName, strings.Join(names, ","))
+}
+
+// parsePgDatabasePrivilegeId splits the resource ID into the instance ID, the database name and the user names.
+// The user names are missing from the IDs created by the earlier versions, which are formatted as
+// <instance_id>/<db_name>.
+func parsePgDatabasePrivilegeId(id string) (instanceId, dbName string, userNames []string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 && len(parts) != 3 || parts[0] == "" || parts[1] == "" ||
+		len(parts) == 3 && parts[2] == "" {
+		return "", "", nil, fmt.Errorf("invalid ID format, want '<instance_id>/<db_name>/<user_names>', "+
+			"but got '%s'", id)
+	}
+	if len(parts) == 3 {
+		userNames = strings.Split(parts[2], ",")
+	}
+	return parts[0], parts[1], userNames, nil
+}
+
+// queryPgAuthorizedUsers returns whether the privileges of the authorized users of the database are read-only,
+// indexed by the user name.
+func queryPgAuthorizedUsers(client *v3.RdsClient, instanceId, dbName string) (map[string]bool, error) {
+	request := rds.ListAuthorizedDbUsersRequest{
+		InstanceId: instanceId,
+		DbName:     dbName,
+		Limit:      int32(100),
+		Page:       int32(1),
+	}
+
+	result := make(map[string]bool)
+	for {
+		response, err := client.ListAuthorizedDbUsers(&request)
+		if err != nil {
+			return nil, err
+		}
+		if response.Users == nil || len(*response.Users) == 0 {
+			break
+		}
+
+		request.Page++
+		for _, user := range *response.Users {
+			result[user.Name] = user.Readonly
+		}
+	}
+	return result, nil
+}
+
+func buildPgUserOpts(rawUsers []interface{}) []rds.PostgresqlUserWithPrivilege {
+	usersOpts := make([]rds.PostgresqlUserWithPrivilege, len(rawUsers))
+	for i, v := range rawUsers {
+		user := v.(map[string]interface{})
+		usersOpts[i] = rds.PostgresqlUserWithPrivilege{
+			Name:       user["name"].(string),
+			SchemaName: user["schema_name"].(string),
+			Readonly:   user["readonly"].(bool),
+		}
+	}
+	return usersOpts
+}
+
+func resourceRdsPgDatabasePrivilegeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	dbName := d.Get("db_name").(string)
+	privilegeReq := rds.AllowDbPrivilegeRequest{
+		InstanceId: instanceId,
+		Body: &rds.PostgresqlGrantRequest{
+			DbName: dbName,
+			Users:  buildPgUserOpts(d.Get("users").(*schema.Set).List()),
+		},
+	}
+	log.Printf("[DEBUG] Create RDS PostgreSQL database privilege options: %#v", privilegeReq)
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		_, err := client.AllowDbPrivilege(&privilegeReq)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS PostgreSQL database privilege: %s", err)
+	}
+
+	d.SetId(buildPgDatabasePrivilegeId(instanceId, dbName, d.Get("users").(*schema.Set).List()))
+	return resourceRdsPgDatabasePrivilegeRead(ctx, d, meta)
+}
+
+// resourceRdsPgDatabasePrivilegeRead refreshes the users from the authorized users of the database, the users whose
+// privileges are revoked outside are removed. The API does not return the schema names, they are kept as is.
+func resourceRdsPgDatabasePrivilegeRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId, dbName, userNames, err := parsePgDatabasePrivilegeId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err = QueryPgDatabase(client, instanceId, dbName); err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RDS PostgreSQL database")
+	}
+	authorizedUsers, err := queryPgAuthorizedUsers(client, instanceId, dbName)
+	if err != nil {
+		return diag.Errorf("error retrieving the authorized users of RDS PostgreSQL database (%s): %s", dbName, err)
+	}
+
+	rawUsers := d.Get("users").(*schema.Set).List()
+	if len(rawUsers) == 0 {
+		// the schema names of the imported users are unknown
+		for _, name := range userNames {
+			rawUsers = append(rawUsers, map[string]interface{}{"name": name, "schema_name": ""})
+		}
+	}
+	users := make([]interface{}, 0, len(rawUsers))
+	for _, v := range rawUsers {
+		user := v.(map[string]interface{})
+		readonly, ok := authorizedUsers[user["name"].(string)]
+		if !ok {
+			log.Printf("[WARN] the privileges of the RDS PostgreSQL account %s on the database %s are revoked",
+				user["name"], dbName)
+			continue
+		}
+		users = append(users, map[string]interface{}{
+			"name":        user["name"],
+			"schema_name": user["schema_name"],
+			"readonly":    readonly,
+		})
+	}
+	if len(users) == 0 {
+		log.Printf("[WARN] the privileges of all the RDS PostgreSQL accounts of %s are revoked", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if len(userNames) == 0 {
+		// the ID created by the earlier versions does not contain the user names
+		d.SetId(buildPgDatabasePrivilegeId(instanceId, dbName, rawUsers))
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", c.GetRegion(d)),
+		d.Set("instance_id", instanceId),
+		d.Set("db_name", dbName),
+		d.Set("users", users),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS PostgreSQL database privilege fields: %s", err)
+	}
+
+	return nil
+}
+
+// resourceRdsPgDatabasePrivilegeDelete only removes the resource from the state, the RDS API can only revoke
+// the privileges of the MySQL accounts.
+func resourceRdsPgDatabasePrivilegeDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	errorMsg := "Deleting RDS PostgreSQL database privilege is not supported. The privilege is only removed from the " +
+		"state, but it remains in the cloud."
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  errorMsg,
+			Detail: fmt.Sprintf("The privileges on the database %s are still granted to the accounts, revoke them "+
+				"with the REVOKE statement of PostgreSQL or delete the accounts.", d.Get("db_name")),
+		},
+	}
+}
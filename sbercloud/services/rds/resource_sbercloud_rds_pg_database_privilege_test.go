@@ -0,0 +1,51 @@
+package rds
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildPgDatabasePrivilegeId(t *testing.T) {
+	rawUsers := []interface{}{
+		map[string]interface{}{"name": "writer", "schema_name": "public"},
+		map[string]interface{}{"name": "reader", "schema_name": "public"},
+	}
+	if id := buildPgDatabasePrivilegeId("instance", "db", rawUsers); id != "instance/db/reader,writer" {
+		t.Errorf("expected the user names to be sorted, got %s", id)
+	}
+}
+
+func TestParsePgDatabasePrivilegeId(t *testing.T) {
+	testCases := []struct {
+		id                 string
+		instanceId, dbName string
+		userNames          []string
+		valid              bool
+	}{
+		{"instance/db/reader,writer", "instance", "db", []string{"reader", "writer"}, true},
+		{"instance/db/reader", "instance", "db", []string{"reader"}, true},
+		{"instance/db", "instance", "db", nil, true},
+		{"instance/db/", "", "", nil, false},
+		{"instance", "", "", nil, false},
+		{"/db/reader", "", "", nil, false},
+		{"instance/db/reader/writer", "", "", nil, false},
+	}
+
+	for _, tc := range testCases {
+		instanceId, dbName, userNames, err := parsePgDatabasePrivilegeId(tc.id)
+		if !tc.valid {
+			if err == nil {
+				t.Errorf("parsing %q, expected an error", tc.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parsing %q, unexpected error: %s", tc.id, err)
+			continue
+		}
+		if instanceId != tc.instanceId || dbName != tc.dbName || !reflect.DeepEqual(userNames, tc.userNames) {
+			t.Errorf("parsing %q, expected %s %s %v, got %s %s %v", tc.id, tc.instanceId, tc.dbName, tc.userNames,
+				instanceId, dbName, userNames)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	rds "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRdsSQLAudit() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsSQLAuditCreateOrUpdate,
+		ReadContext:   resourceRdsSQLAuditRead,
+		UpdateContext: resourceRdsSQLAuditCreateOrUpdate,
+		DeleteContext: resourceRdsSQLAuditDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"keep_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 732),
+			},
+			"reserve_auditlogs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceRdsSQLAuditCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	err = setAuditlogPolicy(ctx, client, instanceId, int32(d.Get("keep_days").(int)), nil, timeout)
+	if err != nil {
+		return diag.Errorf("error setting the SQL audit policy of RDS instance (%s): %s", instanceId, err)
+	}
+
+	d.SetId(instanceId)
+	return resourceRdsSQLAuditRead(ctx, d, meta)
+}
+
+func resourceRdsSQLAuditRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	resp, err := client.ShowAuditlogPolicy(&rds.ShowAuditlogPolicyRequest{InstanceId: d.Id()})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving the SQL audit policy of RDS instance")
+	}
+	if resp.KeepDays == nil || *resp.KeepDays == 0 {
+		log.Printf("[WARN] the SQL audit of RDS instance %s is disabled", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", c.GetRegion(d)),
+		d.Set("instance_id", d.Id()),
+		d.Set("keep_days", resp.KeepDays),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS SQL audit fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRdsSQLAuditDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Id()
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	// setting keep_days to 0 disables the SQL audit
+	err = setAuditlogPolicy(ctx, client, instanceId, 0, utils.Bool(d.Get("reserve_auditlogs").(bool)),
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error disabling the SQL audit of RDS instance")
+	}
+
+	return nil
+}
+
+func setAuditlogPolicy(ctx context.Context, client *v3.RdsClient, instanceId string, keepDays int32,
+	reserveAuditlogs *bool, timeout time.Duration) error {
+	request := rds.SetAuditlogPolicyRequest{
+		InstanceId: instanceId,
+		Body: &rds.SetAuditlogPolicyRequestBody{
+			KeepDays:         keepDays,
+			ReserveAuditlogs: reserveAuditlogs,
+		},
+	}
+	return retryMultiOperations(ctx, timeout, func() error {
+		_, err := client.SetAuditlogPolicy(&request)
+		return err
+	})
+}
@@ -0,0 +1,119 @@
+package vpn
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// gatewayFlavors maps the flavor keys of the availability zone API to the flavor names
+// accepted by sbercloud_vpn_gateway.
+var gatewayFlavors = map[string]string{
+	"basic":         "Basic",
+	"professional1": "Professional1",
+	"professional2": "Professional2",
+	"v1g":           "V1G",
+	"v300":          "V300",
+}
+
+func DataSourceVpnGatewayAZs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVpnGatewayAZsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"flavor": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The flavor of the VPN gateway.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"V1G", "V300", "Basic", "Professional1", "Professional2",
+				}, false),
+			},
+			"attachment_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "vpc",
+				Description: `The attachment type of the VPN gateway.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"vpc", "er",
+				}, false),
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The names of the availability zones which support the flavor.`,
+			},
+		},
+	}
+}
+
+func dataSourceVpnGatewayAZsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	respBody, err := getGatewayAvailabilityZones(cfg, region)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	attachmentType := d.Get("attachment_type").(string)
+	flavor := strings.ToLower(d.Get("flavor").(string))
+	names := utils.PathSearch(fmt.Sprintf("availability_zones.%s.%s", attachmentType, flavor), respBody,
+		make([]interface{}, 0))
+
+	dataSourceId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(dataSourceId)
+
+	mErr := multierror.Append(
+		d.Set("region", region),
+		d.Set("names", names),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+// getGatewayAvailabilityZones queries the availability zones of the VPN gateways, they are
+// grouped by the attachment type and the flavor.
+func getGatewayAvailabilityZones(cfg *config.Config, region string) (interface{}, error) {
+	var (
+		getGatewayAZsHttpUrl = "v5/{project_id}/vpn-gateways/availability-zones"
+		getGatewayAZsProduct = "vpn"
+	)
+	getGatewayAZsClient, err := cfg.NewServiceClient(getGatewayAZsProduct, region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VPN client: %s", err)
+	}
+
+	getGatewayAZsPath := getGatewayAZsClient.Endpoint + getGatewayAZsHttpUrl
+	getGatewayAZsPath = strings.ReplaceAll(getGatewayAZsPath, "{project_id}", getGatewayAZsClient.ProjectID)
+
+	getGatewayAZsOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getGatewayAZsResp, err := getGatewayAZsClient.Request("GET", getGatewayAZsPath, &getGatewayAZsOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving VPN gateway availability zones: %s", err)
+	}
+	return utils.FlattenResponse(getGatewayAZsResp)
+}
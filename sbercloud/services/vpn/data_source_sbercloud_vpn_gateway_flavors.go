@@ -0,0 +1,121 @@
+package vpn
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceVpnGatewayFlavors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVpnGatewayFlavorsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"attachment_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "vpc",
+				Description: `The attachment type of the VPN gateway.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"vpc", "er",
+				}, false),
+			},
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the flavor.`,
+						},
+						"availability_zones": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The names of the availability zones which support the flavor.`,
+						},
+					},
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The names of the flavors.`,
+			},
+		},
+	}
+}
+
+func dataSourceVpnGatewayFlavorsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	respBody, err := getGatewayAvailabilityZones(cfg, region)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dataSourceId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(dataSourceId)
+
+	flavors, names := flattenGatewayFlavors(utils.PathSearch("availability_zones."+d.Get("attachment_type").(string),
+		respBody, nil))
+	mErr := multierror.Append(
+		d.Set("region", region),
+		d.Set("flavors", flavors),
+		d.Set("names", names),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenGatewayFlavors(resp interface{}) ([]map[string]interface{}, []string) {
+	azsByFlavor, ok := resp.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(azsByFlavor))
+	for k := range azsByFlavor {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	flavors := make([]map[string]interface{}, 0, len(keys))
+	names := make([]string, 0, len(keys))
+	for _, k := range keys {
+		azs, _ := azsByFlavor[k].([]interface{})
+		if len(azs) == 0 {
+			continue
+		}
+
+		name, ok := gatewayFlavors[k]
+		if !ok {
+			name = k
+		}
+		flavors = append(flavors, map[string]interface{}{
+			"name":               name,
+			"availability_zones": azs,
+		})
+		names = append(names, name)
+	}
+	return flavors, names
+}
@@ -0,0 +1,116 @@
+package vpn
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+const testGatewayAZsResponse = `{
+  "availability_zones": {
+    "vpc": {
+      "basic": ["ru-moscow-1a", "ru-moscow-1b"],
+      "professional1": ["ru-moscow-1a", "ru-moscow-1b", "ru-moscow-1c"],
+      "professional2": [],
+      "v1g": ["ru-moscow-1b"]
+    },
+    "er": {
+      "professional1": ["ru-moscow-1a"]
+    }
+  }
+}`
+
+// testGatewayConfig returns a config which sends the VPN requests to a local server
+// answering the availability zone API.
+func testGatewayConfig(t *testing.T) *config.Config {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/v5/project-id/vpn-gateways/availability-zones" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, testGatewayAZsResponse)
+	}))
+	t.Cleanup(server.Close)
+
+	return &config.Config{
+		Region:    "ru-moscow-1",
+		Endpoints: map[string]string{"vpn": server.URL + "/"},
+		HwClient:  &golangsdk.ProviderClient{ProjectID: "project-id"},
+	}
+}
+
+func TestDataSourceVpnGatewayAZsRead(t *testing.T) {
+	cases := []struct {
+		raw      map[string]interface{}
+		expected []string
+	}{
+		{
+			raw:      map[string]interface{}{"flavor": "Professional1"},
+			expected: []string{"ru-moscow-1a", "ru-moscow-1b", "ru-moscow-1c"},
+		},
+		{
+			raw:      map[string]interface{}{"flavor": "Professional1", "attachment_type": "er"},
+			expected: []string{"ru-moscow-1a"},
+		},
+		{
+			raw:      map[string]interface{}{"flavor": "Professional2"},
+			expected: []string{},
+		},
+	}
+
+	cfg := testGatewayConfig(t)
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, DataSourceVpnGatewayAZs().Schema, tc.raw)
+		if diags := dataSourceVpnGatewayAZsRead(context.Background(), d, cfg); diags.HasError() {
+			t.Fatalf("error reading the availability zones: %v", diags)
+		}
+
+		names := d.Get("names").([]interface{})
+		if len(names) != len(tc.expected) {
+			t.Fatalf("%v: expected the availability zones %v, got %v", tc.raw, tc.expected, names)
+		}
+		for i, name := range tc.expected {
+			if names[i] != name {
+				t.Errorf("%v: expected the availability zones %v, got %v", tc.raw, tc.expected, names)
+			}
+		}
+		if d.Get("region") != "ru-moscow-1" {
+			t.Errorf("expected the region to be ru-moscow-1, got %v", d.Get("region"))
+		}
+	}
+}
+
+func TestDataSourceVpnGatewayFlavorsRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceVpnGatewayFlavors().Schema, map[string]interface{}{})
+	if diags := dataSourceVpnGatewayFlavorsRead(context.Background(), d, testGatewayConfig(t)); diags.HasError() {
+		t.Fatalf("error reading the flavors: %v", diags)
+	}
+
+	// the flavors without any availability zone are left out
+	expected := []string{"Basic", "Professional1", "V1G"}
+	names := d.Get("names").([]interface{})
+	if len(names) != len(expected) {
+		t.Fatalf("expected the flavors %v, got %v", expected, names)
+	}
+	for i, name := range expected {
+		if names[i] != name {
+			t.Errorf("expected the flavors %v, got %v", expected, names)
+		}
+		if d.Get("flavors."+strconv.Itoa(i)+".name") != name {
+			t.Errorf("expected the flavor %d to be %s, got %v", i, name, d.Get("flavors."+strconv.Itoa(i)+".name"))
+		}
+	}
+	if d.Get("flavors.1.availability_zones.#") != 3 {
+		t.Errorf("expected Professional1 to be supported by 3 availability zones, got %v",
+			d.Get("flavors.1.availability_zones.#"))
+	}
+}